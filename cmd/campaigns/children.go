@@ -0,0 +1,51 @@
+package campaigns
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ChildrenCmd represents the children command for campaigns
+var ChildrenCmd = &cobra.Command{
+	Use:     "children <recurringCampaignId>",
+	Short:   "List the campaigns created by a recurring campaign",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl campaigns children 1234",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid recurringCampaignId: %s", args[0])
+		}
+
+		campaigns, err := client.GetChildCampaigns(id)
+		if err != nil {
+			return fmt.Errorf("error getting child campaigns: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(campaigns)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, campaign := range *campaigns {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", campaign.ID, campaign.Name, campaign.CampaignState, campaign.MessageMedium, utils.FormatMillis(int64(campaign.StartAt)))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	ChildrenCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
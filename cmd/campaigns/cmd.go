@@ -12,4 +12,11 @@ var Cmd = &cobra.Command{
 
 func init() {
 	Cmd.AddCommand(GetCmd)
+	Cmd.AddCommand(MetricsCmd)
+	Cmd.AddCommand(ChildrenCmd)
+	Cmd.AddCommand(CreateCmd)
+	Cmd.AddCommand(TriggerCmd)
+	Cmd.AddCommand(AbortCmd)
+	Cmd.AddCommand(CancelCmd)
+	Cmd.AddCommand(ActivateTriggeredCmd)
 }
@@ -0,0 +1,84 @@
+package campaigns
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// CreateCmd represents the create command for campaigns
+var CreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create and schedule a blast campaign",
+	Example: `iterablectl campaigns create --name "Spring sale" --template-id 42 --list-id 10 --send-at "2025-03-01 09:00:00"
+iterablectl campaigns create --name "Announcement" --template-id 42 --list-id 10 --suppression-list-id 11`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		campaign := iterable.CreateCampaignRequest{}
+		campaign.Name, _ = cmd.Flags().GetString("name")
+		campaign.TemplateID, _ = cmd.Flags().GetInt("template-id")
+		campaign.ListIDs, _ = cmd.Flags().GetIntSlice("list-id")
+		campaign.SuppressionListIDs, _ = cmd.Flags().GetIntSlice("suppression-list-id")
+		campaign.SendMode, _ = cmd.Flags().GetString("send-mode")
+		sendAt, _ := cmd.Flags().GetString("send-at")
+
+		if campaign.Name == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if campaign.TemplateID == 0 {
+			return fmt.Errorf("--template-id is required")
+		}
+		if len(campaign.ListIDs) == 0 {
+			return fmt.Errorf("at least one --list-id must be provided")
+		}
+
+		if sendAt != "" {
+			t, err := utils.ParseTime(sendAt)
+			if err != nil {
+				return err
+			}
+			campaign.SendAt = t
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+		campaign.DataFields = dataFields
+
+		when := "now"
+		if !campaign.SendAt.IsZero() {
+			when = "at " + campaign.SendAt.Local().Format("2006-01-02 15:04:05 MST")
+		}
+		prompt := fmt.Sprintf("Create campaign '%s' sending template %d to lists %s %s?", campaign.Name, campaign.TemplateID, formatIDs(campaign.ListIDs), when)
+		if ok, err := confirm(cmd, prompt); !ok {
+			return err
+		}
+
+		id, err := client.CreateCampaign(campaign)
+		if err != nil {
+			return fmt.Errorf("error creating campaign: %v", err)
+		}
+
+		fmt.Printf("Campaign %d successfully created\n", id)
+		return nil
+	},
+}
+
+func init() {
+	CreateCmd.Flags().String("name", "", "Campaign name")
+	CreateCmd.Flags().Int("template-id", 0, "Template ID to send")
+	CreateCmd.Flags().IntSlice("list-id", []int{}, "List ID to send to (can be used multiple times)")
+	CreateCmd.Flags().IntSlice("suppression-list-id", []int{}, "List ID to suppress (can be used multiple times)")
+	CreateCmd.Flags().String("send-at", "", "Time to send the campaign (RFC 3339, YYYY-MM-DD HH:MM:SS or unix seconds), sends immediately if not set")
+	CreateCmd.Flags().String("send-mode", "", "Send mode: ProjectTimeZone or RecipientTimeZone")
+	CreateCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	CreateCmd.Flags().String("data-file", "", "JSON file containing data fields")
+	CreateCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
@@ -1,43 +1,192 @@
 package campaigns
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 	"text/tabwriter"
+	"time"
 
 	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
 var GetCmd = &cobra.Command{
-	Use:   "get",
-	Short: "Get all campaigns from Iterable",
+	Use:   "get [campaignId]",
+	Short: "Get campaigns from Iterable",
+	Args:  cobra.MaximumNArgs(1),
+	Example: `iterablectl campaigns get
+iterablectl campaigns get --state Running --medium Email --created-after 2025-01-01
+iterablectl campaigns get 1234`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiKey, _ := cmd.Flags().GetString("api-key")
 		client := iterable.NewClient(apiKey)
+		format, _ := cmd.Flags().GetString("format")
+
+		if tree, _ := cmd.Flags().GetBool("tree"); tree {
+			if format == "json" {
+				return fmt.Errorf("--tree cannot be used with --format json")
+			}
+			if len(args) == 1 {
+				return fmt.Errorf("--tree cannot be used with a campaignId")
+			}
+		}
+
+		if len(args) == 1 {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid campaignId: %s", args[0])
+			}
+
+			campaign, err := client.GetCampaign(id)
+			if err != nil {
+				return fmt.Errorf("error getting campaign: %v", err)
+			}
+
+			if format == "json" {
+				return utils.PrintJSON(campaign)
+			}
+
+			printCampaign(campaign)
+			return nil
+		}
 
 		campaigns, err := client.GetCampaigns()
 		if err != nil {
 			return fmt.Errorf("error getting campaigns: %v", err)
 		}
 
-		format, _ := cmd.Flags().GetString("format")
-		if format == "json" {
-			jsonOutput, err := json.MarshalIndent(campaigns, "", "  ")
+		state, _ := cmd.Flags().GetString("state")
+		medium, _ := cmd.Flags().GetString("medium")
+		label, _ := cmd.Flags().GetString("label")
+		createdAfterStr, _ := cmd.Flags().GetString("created-after")
+
+		var createdAfter time.Time
+		if createdAfterStr != "" {
+			createdAfter, err = utils.ParseTime(createdAfterStr)
 			if err != nil {
-				return fmt.Errorf("error formatting JSON: %v", err)
+				return err
 			}
-			fmt.Println(string(jsonOutput))
-			return nil
+		}
+
+		filtered := make([]iterable.Campaign, 0, len(*campaigns))
+		for _, campaign := range *campaigns {
+			if state != "" && campaign.CampaignState != state {
+				continue
+			}
+			if medium != "" && campaign.MessageMedium != medium {
+				continue
+			}
+			if label != "" && !slices.Contains(campaign.Labels, label) {
+				continue
+			}
+			if !createdAfter.IsZero() && !fromMillis(campaign.CreatedAt).After(createdAfter) {
+				continue
+			}
+			filtered = append(filtered, campaign)
+		}
+
+		if format == "json" {
+			return utils.PrintJSON(filtered)
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		for _, list := range *campaigns {
-			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", list.ID, list.Name, list.CampaignState, list.MessageMedium)
+		if tree, _ := cmd.Flags().GetBool("tree"); tree {
+			printCampaignTree(w, filtered)
+		} else {
+			for _, campaign := range filtered {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", campaign.ID, campaign.Name, campaign.CampaignState, campaign.MessageMedium)
+			}
 		}
 		w.Flush()
 
 		return nil
 	},
 }
+
+// printCampaignTree prints campaigns with the children of recurring
+// campaigns indented below their parent. Children whose parent is not in
+// campaigns are printed at the top level.
+func printCampaignTree(w *tabwriter.Writer, campaigns []iterable.Campaign) {
+	ids := make(map[int]bool, len(campaigns))
+	for _, campaign := range campaigns {
+		ids[campaign.ID] = true
+	}
+
+	children := make(map[int][]iterable.Campaign)
+	for _, campaign := range campaigns {
+		if ids[campaign.RecurringCampaignId] {
+			children[campaign.RecurringCampaignId] = append(children[campaign.RecurringCampaignId], campaign)
+		}
+	}
+
+	for _, campaign := range campaigns {
+		if ids[campaign.RecurringCampaignId] {
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", campaign.ID, campaign.Name, campaign.CampaignState, campaign.MessageMedium)
+		for _, child := range children[campaign.ID] {
+			fmt.Fprintf(w, "  └─ %d\t%s\t%s\t%s\n", child.ID, child.Name, child.CampaignState, child.MessageMedium)
+		}
+	}
+}
+
+// printCampaign prints all fields of a campaign as a key/value table
+func printCampaign(campaign *iterable.Campaign) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\t%d\n", campaign.ID)
+	fmt.Fprintf(w, "Name\t%s\n", campaign.Name)
+	fmt.Fprintf(w, "Type\t%s\n", campaign.Type)
+	fmt.Fprintf(w, "State\t%s\n", campaign.CampaignState)
+	fmt.Fprintf(w, "Medium\t%s\n", campaign.MessageMedium)
+	fmt.Fprintf(w, "Template ID\t%d\n", campaign.TemplateId)
+	fmt.Fprintf(w, "Lists\t%s\n", formatIDs(campaign.ListIds))
+	fmt.Fprintf(w, "Suppression Lists\t%s\n", formatIDs(campaign.SuppressionListIds))
+	fmt.Fprintf(w, "Labels\t%s\n", utils.FormatValue(campaign.Labels))
+	fmt.Fprintf(w, "Send Size\t%d\n", campaign.SendSize)
+	fmt.Fprintf(w, "Recurring Campaign ID\t%s\n", formatOptionalID(campaign.RecurringCampaignId))
+	fmt.Fprintf(w, "Workflow ID\t%s\n", formatOptionalID(campaign.WorkflowId))
+	fmt.Fprintf(w, "Created At\t%s\n", utils.FormatMillis(int64(campaign.CreatedAt)))
+	fmt.Fprintf(w, "Created By\t%s\n", campaign.CreatedByUserId)
+	fmt.Fprintf(w, "Updated At\t%s\n", utils.FormatMillis(int64(campaign.UpdatedAt)))
+	fmt.Fprintf(w, "Updated By\t%s\n", campaign.UpdatedByUserId)
+	fmt.Fprintf(w, "Start At\t%s\n", utils.FormatMillis(int64(campaign.StartAt)))
+	fmt.Fprintf(w, "Ended At\t%s\n", utils.FormatMillis(int64(campaign.EndedAt)))
+	w.Flush()
+}
+
+// fromMillis converts a timestamp in milliseconds to a time
+func fromMillis(ms int) time.Time {
+	return time.UnixMilli(int64(ms))
+}
+
+// formatOptionalID formats an ID, rendering unset IDs as an empty string
+func formatOptionalID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// formatIDs formats a list of IDs as a comma-separated string
+func formatIDs(ids []int) string {
+	s := ""
+	for i, id := range ids {
+		if i > 0 {
+			s += ", "
+		}
+		s += strconv.Itoa(id)
+	}
+	return s
+}
+
+func init() {
+	GetCmd.Flags().String("format", "table", "Output format: json or table (default)")
+	GetCmd.Flags().String("state", "", "Only show campaigns in this state, e.g. Running, Finished, Draft")
+	GetCmd.Flags().String("medium", "", "Only show campaigns with this message medium, e.g. Email, SMS, Push")
+	GetCmd.Flags().String("label", "", "Only show campaigns with this label")
+	GetCmd.Flags().Bool("tree", false, "Group child campaigns under their recurring parent campaign (table output only)")
+	GetCmd.Flags().String("created-after", "", "Only show campaigns created after this time (RFC 3339, YYYY-MM-DD or unix seconds)")
+}
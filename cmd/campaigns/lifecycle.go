@@ -0,0 +1,146 @@
+package campaigns
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// campaignTransition describes a campaign state change and the states it is
+// allowed from
+type campaignTransition struct {
+	verb          string
+	campaignType  string // required campaign type, empty for any
+	allowedStates []string
+	apply         func(client *iterable.Client, id int) error
+}
+
+// AbortCmd represents the abort command for campaigns
+var AbortCmd = &cobra.Command{
+	Use:     "abort <campaignId>",
+	Short:   "Abort a campaign that is currently sending",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl campaigns abort 1234",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTransition(cmd, args[0], campaignTransition{
+			verb:          "abort",
+			allowedStates: []string{"Starting", "Running"},
+			apply:         (*iterable.Client).AbortCampaign,
+		})
+	},
+}
+
+// CancelCmd represents the cancel command for campaigns
+var CancelCmd = &cobra.Command{
+	Use:     "cancel <campaignId>",
+	Short:   "Cancel a scheduled or recurring campaign",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl campaigns cancel 1234",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTransition(cmd, args[0], campaignTransition{
+			verb:          "cancel",
+			allowedStates: []string{"Scheduled", "Recurring"},
+			apply:         (*iterable.Client).CancelCampaign,
+		})
+	},
+}
+
+// ActivateTriggeredCmd represents the activate-triggered command for campaigns
+var ActivateTriggeredCmd = &cobra.Command{
+	Use:     "activate-triggered <campaignId>",
+	Short:   "Activate a triggered campaign so it can be sent",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl campaigns activate-triggered 1234",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTransition(cmd, args[0], campaignTransition{
+			verb:          "activate",
+			campaignType:  "Triggered",
+			allowedStates: []string{"Ready"},
+			apply:         (*iterable.Client).ActivateTriggeredCampaign,
+		})
+	},
+}
+
+// runTransition checks the campaign's current state, asks for confirmation
+// and applies the transition
+func runTransition(cmd *cobra.Command, arg string, t campaignTransition) error {
+	apiKey, _ := cmd.Flags().GetString("api-key")
+	client := iterable.NewClient(apiKey)
+
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		return fmt.Errorf("invalid campaignId: %s", arg)
+	}
+
+	campaign, err := client.GetCampaign(id)
+	if err != nil {
+		return fmt.Errorf("error getting campaign: %v", err)
+	}
+	if err := checkTransition(campaign, t.verb, t.campaignType, t.allowedStates); err != nil {
+		return err
+	}
+
+	if ok, err := confirm(cmd, fmt.Sprintf("%s campaign %d '%s'?", utils.Capitalize(t.verb), campaign.ID, campaign.Name)); !ok {
+		return err
+	}
+
+	if err := t.apply(client, id); err != nil {
+		return fmt.Errorf("error trying to %s campaign: %v", t.verb, err)
+	}
+
+	fmt.Printf("Campaign %d successfully %s\n", id, pastTense(t.verb))
+	return nil
+}
+
+// checkTransition returns an error if the campaign cannot be moved out of
+// its current state
+func checkTransition(campaign *iterable.Campaign, verb, campaignType string, allowedStates []string) error {
+	if campaignType != "" && campaign.Type != campaignType {
+		return fmt.Errorf("cannot %s campaign %d: campaign type is %s, expected %s", verb, campaign.ID, campaign.Type, campaignType)
+	}
+	if !slices.Contains(allowedStates, campaign.CampaignState) {
+		return fmt.Errorf("cannot %s campaign %d in state %s, must be one of: %s", verb, campaign.ID, campaign.CampaignState, strings.Join(allowedStates, ", "))
+	}
+	return nil
+}
+
+// confirm asks for confirmation unless --yes was passed. It returns false
+// with a nil error if the user declined.
+func confirm(cmd *cobra.Command, prompt string) (bool, error) {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return true, nil
+	}
+
+	ok, err := utils.Confirm(prompt)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		fmt.Println("Aborted")
+	}
+	return ok, nil
+}
+
+func pastTense(verb string) string {
+	switch verb {
+	case "cancel":
+		return "cancelled"
+	case "trigger":
+		return "triggered"
+	}
+	if strings.HasSuffix(verb, "e") {
+		return verb + "d"
+	}
+	return verb + "ed"
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{AbortCmd, CancelCmd, ActivateTriggeredCmd} {
+		cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	}
+}
@@ -0,0 +1,112 @@
+package campaigns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+)
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name          string
+		campaign      iterable.Campaign
+		verb          string
+		campaignType  string
+		allowedStates []string
+		wantErr       string
+	}{
+		{
+			name:          "abort running campaign",
+			campaign:      iterable.Campaign{ID: 1, Type: "Blast", CampaignState: "Running"},
+			verb:          "abort",
+			allowedStates: []string{"Starting", "Running"},
+		},
+		{
+			name:          "abort finished campaign",
+			campaign:      iterable.Campaign{ID: 1, Type: "Blast", CampaignState: "Finished"},
+			verb:          "abort",
+			allowedStates: []string{"Starting", "Running"},
+			wantErr:       "cannot abort campaign 1 in state Finished, must be one of: Starting, Running",
+		},
+		{
+			name:          "cancel scheduled campaign",
+			campaign:      iterable.Campaign{ID: 2, Type: "Blast", CampaignState: "Scheduled"},
+			verb:          "cancel",
+			allowedStates: []string{"Scheduled", "Recurring"},
+		},
+		{
+			name:          "cancel running campaign",
+			campaign:      iterable.Campaign{ID: 2, Type: "Blast", CampaignState: "Running"},
+			verb:          "cancel",
+			allowedStates: []string{"Scheduled", "Recurring"},
+			wantErr:       "cannot cancel campaign 2 in state Running, must be one of: Scheduled, Recurring",
+		},
+		{
+			name:          "activate ready triggered campaign",
+			campaign:      iterable.Campaign{ID: 3, Type: "Triggered", CampaignState: "Ready"},
+			verb:          "activate",
+			campaignType:  "Triggered",
+			allowedStates: []string{"Ready"},
+		},
+		{
+			name:          "activate blast campaign",
+			campaign:      iterable.Campaign{ID: 3, Type: "Blast", CampaignState: "Ready"},
+			verb:          "activate",
+			campaignType:  "Triggered",
+			allowedStates: []string{"Ready"},
+			wantErr:       "cannot activate campaign 3: campaign type is Blast, expected Triggered",
+		},
+		{
+			name:          "activate running triggered campaign",
+			campaign:      iterable.Campaign{ID: 3, Type: "Triggered", CampaignState: "Running"},
+			verb:          "activate",
+			campaignType:  "Triggered",
+			allowedStates: []string{"Ready"},
+			wantErr:       "cannot activate campaign 3 in state Running, must be one of: Ready",
+		},
+		{
+			name:          "trigger ready triggered campaign",
+			campaign:      iterable.Campaign{ID: 4, Type: "Triggered", CampaignState: "Ready"},
+			verb:          "trigger",
+			campaignType:  "Triggered",
+			allowedStates: []string{"Running"},
+			wantErr:       "cannot trigger campaign 4 in state Ready, must be one of: Running",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTransition(&tt.campaign, tt.verb, tt.campaignType, tt.allowedStates)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkTransition() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkTransition() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPastTense(t *testing.T) {
+	tests := []struct {
+		verb string
+		want string
+	}{
+		{verb: "abort", want: "aborted"},
+		{verb: "cancel", want: "cancelled"},
+		{verb: "activate", want: "activated"},
+		{verb: "trigger", want: "triggered"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.verb, func(t *testing.T) {
+			if got := pastTense(tt.verb); got != tt.want {
+				t.Errorf("pastTense(%q) = %q, want %q", tt.verb, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package campaigns
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// MetricsCmd represents the metrics command for campaigns
+var MetricsCmd = &cobra.Command{
+	Use:   "metrics <campaignId>...",
+	Short: "Get metrics for one or more campaigns",
+	Args:  cobra.MinimumNArgs(1),
+	Example: `iterablectl campaigns metrics 1234
+iterablectl campaigns metrics 1234 5678 --start 2025-01-01 --end 2025-02-01`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		ids := make([]int, 0, len(args))
+		for _, arg := range args {
+			id, err := strconv.Atoi(arg)
+			if err != nil {
+				return fmt.Errorf("invalid campaignId: %s", arg)
+			}
+			ids = append(ids, id)
+		}
+
+		var start, end time.Time
+		var err error
+		if s, _ := cmd.Flags().GetString("start"); s != "" {
+			if start, err = utils.ParseTime(s); err != nil {
+				return err
+			}
+		}
+		if s, _ := cmd.Flags().GetString("end"); s != "" {
+			if end, err = utils.ParseTime(s); err != nil {
+				return err
+			}
+		}
+
+		metrics, err := client.GetCampaignMetrics(ids, start, end)
+		if err != nil {
+			return fmt.Errorf("error getting campaign metrics: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			rows := make([]map[string]string, 0, len(metrics.Rows))
+			for i := range metrics.Rows {
+				row := make(map[string]string, len(metrics.Columns))
+				for _, column := range metrics.Columns {
+					row[column] = metrics.Value(i, column)
+				}
+				rows = append(rows, row)
+			}
+
+			return utils.PrintJSON(rows)
+		}
+
+		// Metrics have many columns, so print one row per metric and one
+		// column per campaign
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, column := range metrics.Columns {
+			values := make([]string, 0, len(metrics.Rows))
+			for i := range metrics.Rows {
+				values = append(values, metrics.Value(i, column))
+			}
+			fmt.Fprintf(w, "%s\t%s\n", column, strings.Join(values, "\t"))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	MetricsCmd.Flags().String("start", "", "Start of the date range (RFC 3339, YYYY-MM-DD or unix seconds)")
+	MetricsCmd.Flags().String("end", "", "End of the date range (RFC 3339, YYYY-MM-DD or unix seconds)")
+	MetricsCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
@@ -0,0 +1,68 @@
+package campaigns
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// TriggerCmd represents the trigger command for campaigns
+var TriggerCmd = &cobra.Command{
+	Use:     "trigger <campaignId>",
+	Short:   "Send an active triggered campaign to lists",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl campaigns trigger 1234 --list-id 10 --list-id 11",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid campaignId: %s", args[0])
+		}
+
+		listIDs, _ := cmd.Flags().GetIntSlice("list-id")
+		suppressionListIDs, _ := cmd.Flags().GetIntSlice("suppression-list-id")
+		if len(listIDs) == 0 {
+			return fmt.Errorf("at least one --list-id must be provided")
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+
+		campaign, err := client.GetCampaign(id)
+		if err != nil {
+			return fmt.Errorf("error getting campaign: %v", err)
+		}
+		if err := checkTransition(campaign, "trigger", "Triggered", []string{"Running"}); err != nil {
+			return err
+		}
+
+		prompt := fmt.Sprintf("Send campaign %d '%s' to lists %s?", campaign.ID, campaign.Name, formatIDs(listIDs))
+		if ok, err := confirm(cmd, prompt); !ok {
+			return err
+		}
+
+		if err := client.TriggerCampaign(id, listIDs, suppressionListIDs, dataFields); err != nil {
+			return fmt.Errorf("error triggering campaign: %v", err)
+		}
+
+		fmt.Printf("Campaign %d successfully triggered\n", id)
+		return nil
+	},
+}
+
+func init() {
+	TriggerCmd.Flags().IntSlice("list-id", []int{}, "List ID to send to (can be used multiple times)")
+	TriggerCmd.Flags().IntSlice("suppression-list-id", []int{}, "List ID to suppress (can be used multiple times)")
+	TriggerCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	TriggerCmd.Flags().String("data-file", "", "JSON file containing data fields")
+	TriggerCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
@@ -0,0 +1,88 @@
+package catalogs
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd represents the list command for catalogs
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List catalogs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		names, err := client.GetCatalogs()
+		if err != nil {
+			return fmt.Errorf("error getting catalogs: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(names)
+		}
+
+		for _, name := range names {
+			fmt.Println(name)
+		}
+		return nil
+	},
+}
+
+// CreateCmd represents the create command for catalogs
+var CreateCmd = &cobra.Command{
+	Use:     "create <catalogName>",
+	Short:   "Create a catalog",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl catalogs create products",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		if err := client.CreateCatalog(args[0]); err != nil {
+			return fmt.Errorf("error creating catalog: %v", err)
+		}
+
+		fmt.Printf("Catalog '%s' successfully created\n", args[0])
+		return nil
+	},
+}
+
+// DeleteCmd represents the delete command for catalogs
+var DeleteCmd = &cobra.Command{
+	Use:     "delete <catalogName>",
+	Short:   "Delete a catalog and all of its items",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl catalogs delete products",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			ok, err := utils.Confirm(fmt.Sprintf("Delete catalog '%s' and all of its items?", args[0]))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted")
+				return nil
+			}
+		}
+
+		if err := client.DeleteCatalog(args[0]); err != nil {
+			return fmt.Errorf("error deleting catalog: %v", err)
+		}
+
+		fmt.Printf("Catalog '%s' successfully deleted\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	ListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+	DeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
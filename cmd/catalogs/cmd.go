@@ -0,0 +1,19 @@
+package catalogs
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the catalogs command
+var Cmd = &cobra.Command{
+	Use:   "catalogs",
+	Short: "Manage Iterable catalogs",
+}
+
+func init() {
+	Cmd.AddCommand(ListCmd)
+	Cmd.AddCommand(CreateCmd)
+	Cmd.AddCommand(DeleteCmd)
+	Cmd.AddCommand(ItemsCmd)
+	Cmd.AddCommand(FieldMappingsCmd)
+}
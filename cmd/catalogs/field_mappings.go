@@ -0,0 +1,114 @@
+package catalogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// FieldMappingsCmd represents the field-mappings command for catalogs
+var FieldMappingsCmd = &cobra.Command{
+	Use:   "field-mappings",
+	Short: "Manage catalog field types",
+}
+
+// FieldMappingsGetCmd represents the field-mappings get command for catalogs
+var FieldMappingsGetCmd = &cobra.Command{
+	Use:     "get <catalogName>",
+	Short:   "Get the field types of a catalog",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl catalogs field-mappings get products",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		mappings, err := client.GetCatalogFieldMappings(args[0])
+		if err != nil {
+			return fmt.Errorf("error getting field mappings: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(mappings)
+		}
+
+		names := make([]string, 0, len(mappings.DefinedMappings))
+		for name := range mappings.DefinedMappings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, name := range names {
+			fmt.Fprintf(w, "%s\t%s\n", name, mappings.DefinedMappings[name])
+		}
+		for _, name := range mappings.UndefinedFields {
+			fmt.Fprintf(w, "%s\t<undefined>\n", name)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// FieldMappingsSetCmd represents the field-mappings set command for catalogs
+var FieldMappingsSetCmd = &cobra.Command{
+	Use:   "set <catalogName>",
+	Short: "Define field types for a catalog",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl catalogs field-mappings set products --field price=double --field name=string
+iterablectl catalogs field-mappings set products --file mappings.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		mappings := make(map[string]string)
+
+		file, _ := cmd.Flags().GetString("file")
+		if file != "" {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read mappings file: %v", err)
+			}
+			if err := json.Unmarshal(data, &mappings); err != nil {
+				return fmt.Errorf("failed to parse mappings file as JSON: %v", err)
+			}
+		}
+
+		fields, _ := cmd.Flags().GetStringArray("field")
+		for _, field := range fields {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid field format: %s, expected format is name=type", field)
+			}
+			mappings[parts[0]] = parts[1]
+		}
+
+		if len(mappings) == 0 {
+			return fmt.Errorf("either --field or --file must be provided")
+		}
+
+		if err := client.SetCatalogFieldMappings(args[0], mappings); err != nil {
+			return fmt.Errorf("error setting field mappings: %v", err)
+		}
+
+		fmt.Printf("Field mappings for catalog '%s' successfully updated\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	FieldMappingsCmd.AddCommand(FieldMappingsGetCmd)
+	FieldMappingsCmd.AddCommand(FieldMappingsSetCmd)
+
+	FieldMappingsGetCmd.Flags().String("format", "table", "Output format: json or table (default)")
+	FieldMappingsSetCmd.Flags().StringArray("field", []string{}, "Field type in name=type format, e.g. price=double (can be used multiple times)")
+	FieldMappingsSetCmd.Flags().String("file", "", "JSON file mapping field names to types")
+}
@@ -0,0 +1,125 @@
+package catalogs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// ItemsImportCmd represents the items import command for catalogs
+var ItemsImportCmd = &cobra.Command{
+	Use:   "import <catalogName>",
+	Short: "Create or replace catalog items from an NDJSON file",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl catalogs items import products --file items.ndjson
+iterablectl catalogs items import products --file items.ndjson --id-field sku --replace-uploaded-fields-only`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		file, _ := cmd.Flags().GetString("file")
+		idField, _ := cmd.Flags().GetString("id-field")
+		batchSize, _ := cmd.Flags().GetInt("batch-size")
+		replaceUploadedFieldsOnly, _ := cmd.Flags().GetBool("replace-uploaded-fields-only")
+
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if batchSize <= 0 || batchSize > 1000 {
+			return fmt.Errorf("--batch-size must be between 1 and 1000")
+		}
+
+		// Read and check the whole file first so that an invalid line or a
+		// duplicate ID does not leave the import half applied
+		ids, items, err := readCatalogItems(file, idField)
+		if err != nil {
+			return err
+		}
+
+		imported := 0
+		for start := 0; start < len(items); start += batchSize {
+			end := min(start+batchSize, len(items))
+			batch := make(map[string]map[string]any, end-start)
+			for i := start; i < end; i++ {
+				batch[ids[i]] = items[i]
+			}
+			if err := client.BulkPutCatalogItems(args[0], batch, replaceUploadedFieldsOnly); err != nil {
+				return fmt.Errorf("error importing items %d-%d: %v", start+1, end, err)
+			}
+			imported += len(batch)
+		}
+
+		// Bulk item updates are applied asynchronously by Iterable
+		fmt.Printf("Submitted %d items to catalog '%s'\n", imported, args[0])
+		return nil
+	},
+}
+
+// readCatalogItems reads items from an NDJSON file, returning their IDs and
+// the items in file order. Items in a bulk request are keyed by ID, so a
+// duplicate ID would silently replace the earlier item and is rejected.
+func readCatalogItems(file, idField string) ([]string, []map[string]any, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	defer f.Close()
+
+	var ids []string
+	var items []map[string]any
+	seen := map[string]int{}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var item map[string]any
+		if err := json.Unmarshal([]byte(line), &item); err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid item: %v", n, err)
+		}
+
+		id, ok := item[idField]
+		if !ok || id == nil || id == "" {
+			return nil, nil, fmt.Errorf("line %d: missing %q field", n, idField)
+		}
+
+		var itemID string
+		switch value := id.(type) {
+		case string:
+			itemID = value
+		case float64:
+			itemID = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			return nil, nil, fmt.Errorf("line %d: %q must be a string or a number", n, idField)
+		}
+
+		if first, ok := seen[itemID]; ok {
+			return nil, nil, fmt.Errorf("line %d: duplicate item ID %q, first seen on line %d", n, itemID, first)
+		}
+		seen[itemID] = n
+		ids = append(ids, itemID)
+		items = append(items, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	return ids, items, nil
+}
+
+func init() {
+	ItemsImportCmd.Flags().String("file", "", "NDJSON file with one item object per line")
+	ItemsImportCmd.Flags().String("id-field", "id", "Item field holding the item ID")
+	ItemsImportCmd.Flags().Int("batch-size", 1000, "Number of items per request (at most 1000)")
+	ItemsImportCmd.Flags().Bool("replace-uploaded-fields-only", false, "Keep fields of existing items that are not in the file")
+}
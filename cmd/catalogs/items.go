@@ -0,0 +1,182 @@
+package catalogs
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ItemsCmd represents the items command for catalogs
+var ItemsCmd = &cobra.Command{
+	Use:   "items",
+	Short: "Manage catalog items",
+}
+
+// ItemsListCmd represents the items list command for catalogs
+var ItemsListCmd = &cobra.Command{
+	Use:   "list <catalogName>",
+	Short: "List items in a catalog",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl catalogs items list products
+iterablectl catalogs items list products --all --format json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		page, _ := cmd.Flags().GetInt("page")
+		pageSize, _ := cmd.Flags().GetInt("page-size")
+		all, _ := cmd.Flags().GetBool("all")
+
+		var items []iterable.CatalogItem
+		for {
+			pageItems, more, err := client.GetCatalogItems(args[0], page, pageSize)
+			if err != nil {
+				return fmt.Errorf("error getting catalog items: %v", err)
+			}
+			items = append(items, pageItems...)
+
+			if !all || !more {
+				break
+			}
+			page++
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(items)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, item := range items {
+			lastModified := utils.FormatMillis(item.LastModified)
+			fmt.Fprintf(w, "%s\t%s\t%s\n", item.ItemID, lastModified, utils.FormatValue(item.Value))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// ItemsGetCmd represents the items get command for catalogs
+var ItemsGetCmd = &cobra.Command{
+	Use:     "get <catalogName> <itemId>",
+	Short:   "Get a catalog item",
+	Args:    cobra.ExactArgs(2),
+	Example: "iterablectl catalogs items get products sku-1",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		item, err := client.GetCatalogItem(args[0], args[1])
+		if err != nil {
+			return fmt.Errorf("error getting catalog item: %v", err)
+		}
+
+		return utils.PrintJSON(item.Value)
+	},
+}
+
+// ItemsPutCmd represents the items put command for catalogs
+var ItemsPutCmd = &cobra.Command{
+	Use:   "put <catalogName> <itemId>",
+	Short: "Create or replace a catalog item",
+	Args:  cobra.ExactArgs(2),
+	Example: `iterablectl catalogs items put products sku-1 --data-file item.json
+iterablectl catalogs items put products sku-1 --data-field name=Shirt --data-field color=blue`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		value, err := itemValue(cmd)
+		if err != nil {
+			return err
+		}
+
+		if err := client.PutCatalogItem(args[0], args[1], value); err != nil {
+			return fmt.Errorf("error putting catalog item: %v", err)
+		}
+
+		fmt.Printf("Item '%s' successfully saved\n", args[1])
+		return nil
+	},
+}
+
+// ItemsPatchCmd represents the items patch command for catalogs
+var ItemsPatchCmd = &cobra.Command{
+	Use:     "patch <catalogName> <itemId>",
+	Short:   "Update fields of a catalog item",
+	Args:    cobra.ExactArgs(2),
+	Example: "iterablectl catalogs items patch products sku-1 --data-field color=red",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		update, err := itemValue(cmd)
+		if err != nil {
+			return err
+		}
+
+		if err := client.PatchCatalogItem(args[0], args[1], update); err != nil {
+			return fmt.Errorf("error patching catalog item: %v", err)
+		}
+
+		fmt.Printf("Item '%s' successfully updated\n", args[1])
+		return nil
+	},
+}
+
+// ItemsDeleteCmd represents the items delete command for catalogs
+var ItemsDeleteCmd = &cobra.Command{
+	Use:     "delete <catalogName> <itemId>",
+	Short:   "Delete a catalog item",
+	Args:    cobra.ExactArgs(2),
+	Example: "iterablectl catalogs items delete products sku-1",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		if err := client.DeleteCatalogItem(args[0], args[1]); err != nil {
+			return fmt.Errorf("error deleting catalog item: %v", err)
+		}
+
+		fmt.Printf("Item '%s' successfully deleted\n", args[1])
+		return nil
+	},
+}
+
+// itemValue reads an item value from --data-field and --data-file
+func itemValue(cmd *cobra.Command) (map[string]any, error) {
+	dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+	dataFile, _ := cmd.Flags().GetString("data-file")
+	value, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("either --data-field or --data-file must be provided")
+	}
+	return value, nil
+}
+
+func init() {
+	ItemsCmd.AddCommand(ItemsListCmd)
+	ItemsCmd.AddCommand(ItemsGetCmd)
+	ItemsCmd.AddCommand(ItemsPutCmd)
+	ItemsCmd.AddCommand(ItemsPatchCmd)
+	ItemsCmd.AddCommand(ItemsDeleteCmd)
+	ItemsCmd.AddCommand(ItemsImportCmd)
+
+	ItemsListCmd.Flags().Int("page", 1, "Page to fetch, starting at 1")
+	ItemsListCmd.Flags().Int("page-size", 100, "Number of items per page")
+	ItemsListCmd.Flags().Bool("all", false, "Fetch all pages starting at --page")
+	ItemsListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+
+	for _, cmd := range []*cobra.Command{ItemsPutCmd, ItemsPatchCmd} {
+		cmd.Flags().StringArray("data-field", []string{}, "Item field in key=value format (can be used multiple times)")
+		cmd.Flags().String("data-file", "", "JSON file containing item fields")
+	}
+}
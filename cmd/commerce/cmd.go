@@ -0,0 +1,16 @@
+package commerce
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the commerce command
+var Cmd = &cobra.Command{
+	Use:   "commerce",
+	Short: "Track purchases and update shopping carts",
+}
+
+func init() {
+	Cmd.AddCommand(TrackPurchaseCmd)
+	Cmd.AddCommand(UpdateCartCmd)
+}
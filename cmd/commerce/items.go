@@ -0,0 +1,115 @@
+package commerce
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+)
+
+// readItems reads commerce items from a JSON array or a CSV file with a
+// header row. CSV columns that are not item fields become item data fields,
+// and categories are separated by semicolons.
+func readItems(path string) ([]iterable.CommerceItem, error) {
+	var items []iterable.CommerceItem
+	var err error
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		items, err = readCSVItems(path)
+	} else {
+		items, err = readJSONItems(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items found in %s", path)
+	}
+	for i, item := range items {
+		if err := item.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid item %d: %v", i+1, strings.ReplaceAll(err.Error(), "\n", ", "))
+		}
+	}
+
+	return items, nil
+}
+
+func readJSONItems(path string) ([]iterable.CommerceItem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read items file: %v", err)
+	}
+
+	var items []iterable.CommerceItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse items file as JSON: %v", err)
+	}
+
+	return items, nil
+}
+
+func readCSVItems(path string) ([]iterable.CommerceItem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read items file: %v", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse items file as CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	items := make([]iterable.CommerceItem, 0, len(records)-1)
+	for row, record := range records[1:] {
+		var item iterable.CommerceItem
+		for col, value := range record {
+			switch header[col] {
+			case "id":
+				item.ID = value
+			case "sku":
+				item.SKU = value
+			case "name":
+				item.Name = value
+			case "description":
+				item.Description = value
+			case "categories":
+				if value != "" {
+					item.Categories = strings.Split(value, ";")
+				}
+			case "price":
+				price, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid item %d: invalid price %q", row+1, value)
+				}
+				item.Price = &price
+			case "quantity":
+				item.Quantity, err = strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid item %d: invalid quantity %q", row+1, value)
+				}
+			case "imageUrl":
+				item.ImageURL = value
+			case "url":
+				item.URL = value
+			default:
+				if item.DataFields == nil {
+					item.DataFields = make(map[string]any)
+				}
+				item.DataFields[header[col]] = value
+			}
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
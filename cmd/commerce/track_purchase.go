@@ -0,0 +1,95 @@
+package commerce
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// TrackPurchaseCmd represents the track-purchase command for commerce
+var TrackPurchaseCmd = &cobra.Command{
+	Use:   "track-purchase",
+	Short: "Track a purchase for a user",
+	Example: `iterablectl commerce track-purchase --email user@example.com --items-file items.json
+iterablectl commerce track-purchase --user-id 42 --items-file items.csv --total 59.90 --campaign-id 1234`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+		itemsFile, _ := cmd.Flags().GetString("items-file")
+		createdAt, _ := cmd.Flags().GetString("created-at")
+
+		if (email == "" && userId == "") || (email != "" && userId != "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+		if itemsFile == "" {
+			return fmt.Errorf("--items-file is required")
+		}
+
+		items, err := readItems(itemsFile)
+		if err != nil {
+			return err
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+
+		purchase := iterable.Purchase{
+			User: iterable.UserUpdateRequest{
+				Email:        email,
+				UserID:       userId,
+				PreferUserId: userId != "",
+			},
+			Items:      items,
+			DataFields: dataFields,
+		}
+		purchase.ID, _ = cmd.Flags().GetString("id")
+		purchase.CampaignID, _ = cmd.Flags().GetInt("campaign-id")
+		purchase.TemplateID, _ = cmd.Flags().GetInt("template-id")
+
+		// Default the total to the sum of the items
+		if cmd.Flags().Changed("total") {
+			purchase.Total, _ = cmd.Flags().GetFloat64("total")
+		} else {
+			for _, item := range items {
+				purchase.Total += *item.Price * float64(item.Quantity)
+			}
+		}
+
+		if createdAt != "" {
+			t, err := utils.ParseTime(createdAt)
+			if err != nil {
+				return err
+			}
+			purchase.CreatedAt = t.Unix()
+		}
+
+		if err := client.TrackPurchase(purchase); err != nil {
+			return fmt.Errorf("error tracking purchase: %v", err)
+		}
+
+		fmt.Printf("Purchase of %d items tracked successfully\n", len(items))
+		return nil
+	},
+}
+
+func init() {
+	TrackPurchaseCmd.Flags().String("email", "", "User email address")
+	TrackPurchaseCmd.Flags().String("user-id", "", "User ID")
+	TrackPurchaseCmd.Flags().String("items-file", "", "JSON or CSV file containing the purchased items")
+	TrackPurchaseCmd.Flags().String("id", "", "Purchase ID, generated by Iterable if not set")
+	TrackPurchaseCmd.Flags().Float64("total", 0, "Purchase total (defaults to the sum of item prices)")
+	TrackPurchaseCmd.Flags().Int("campaign-id", 0, "Campaign ID to attribute the purchase to")
+	TrackPurchaseCmd.Flags().Int("template-id", 0, "Template ID to attribute the purchase to")
+	TrackPurchaseCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	TrackPurchaseCmd.Flags().String("data-file", "", "JSON file containing data fields")
+	TrackPurchaseCmd.Flags().String("created-at", "", "Time of the purchase (RFC 3339, YYYY-MM-DD or unix seconds), defaults to now")
+}
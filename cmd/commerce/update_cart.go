@@ -0,0 +1,53 @@
+package commerce
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// UpdateCartCmd represents the update-cart command for commerce
+var UpdateCartCmd = &cobra.Command{
+	Use:     "update-cart",
+	Short:   "Replace the items in a user's shopping cart",
+	Example: `iterablectl commerce update-cart --email user@example.com --items-file cart.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+		itemsFile, _ := cmd.Flags().GetString("items-file")
+
+		if (email == "" && userId == "") || (email != "" && userId != "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+		if itemsFile == "" {
+			return fmt.Errorf("--items-file is required")
+		}
+
+		items, err := readItems(itemsFile)
+		if err != nil {
+			return err
+		}
+
+		user := iterable.UserUpdateRequest{
+			Email:        email,
+			UserID:       userId,
+			PreferUserId: userId != "",
+		}
+		if err := client.UpdateCart(user, items); err != nil {
+			return fmt.Errorf("error updating cart: %v", err)
+		}
+
+		fmt.Printf("Cart updated with %d items\n", len(items))
+		return nil
+	},
+}
+
+func init() {
+	UpdateCartCmd.Flags().String("email", "", "User email address")
+	UpdateCartCmd.Flags().String("user-id", "", "User ID")
+	UpdateCartCmd.Flags().String("items-file", "", "JSON or CSV file containing the cart items")
+}
@@ -0,0 +1,18 @@
+package devices
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the devices command
+var Cmd = &cobra.Command{
+	Use:   "devices",
+	Short: "Manage push notification devices",
+}
+
+func init() {
+	Cmd.AddCommand(ListCmd)
+	Cmd.AddCommand(RegisterCmd)
+	Cmd.AddCommand(RegisterBrowserCmd)
+	Cmd.AddCommand(DisableCmd)
+}
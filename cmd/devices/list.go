@@ -0,0 +1,87 @@
+package devices
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd represents the list command for devices
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List a user's registered devices and browser tokens",
+	Example: `iterablectl devices list --email user@example.com
+iterablectl devices list --user-id 42 --format json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		user, err := getUser(cmd, client)
+		if err != nil {
+			return err
+		}
+
+		devices, err := user.Devices()
+		if err != nil {
+			return err
+		}
+
+		browserTokens, err := user.BrowserTokens()
+		if err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(map[string]any{
+				"devices":       devices,
+				"browserTokens": browserTokens,
+			})
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "PLATFORM\tAPP\tENABLED\tNOTIFICATIONS\tTOKEN")
+		for _, device := range devices {
+			fmt.Fprintf(w, "%s\t%s\t%t\t%t\t%s\n", device.Platform, device.ApplicationName, device.EndpointEnabled, device.NotificationsEnabled, device.Token)
+		}
+		for _, token := range browserTokens {
+			fmt.Fprintf(w, "BROWSER\t\t\t\t%s\n", token)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// getUser fetches the user selected with --email or --user-id
+func getUser(cmd *cobra.Command, client *iterable.Client) (*iterable.User, error) {
+	email, _ := cmd.Flags().GetString("email")
+	userId, _ := cmd.Flags().GetString("user-id")
+
+	if (email == "") == (userId == "") {
+		return nil, fmt.Errorf("exactly one of --email or --user-id must be specified")
+	}
+
+	var user *iterable.User
+	var err error
+	if userId != "" {
+		user, err = client.GetUserByID(userId)
+	} else {
+		user, err = client.GetUser(email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %v", err)
+	}
+
+	return user, nil
+}
+
+func init() {
+	ListCmd.Flags().String("email", "", "User email address")
+	ListCmd.Flags().String("user-id", "", "User ID")
+	ListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
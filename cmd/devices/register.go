@@ -0,0 +1,145 @@
+package devices
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// platforms lists the supported push platforms
+var platforms = []string{"APNS", "APNS_SANDBOX", "GCM"}
+
+// RegisterCmd represents the register command for devices
+var RegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Register a push token for a user",
+	Example: `iterablectl devices register --email user@example.com --token abc123 --platform APNS --app-name com.example.app
+iterablectl devices register --user-id 42 --token abc123 --platform GCM --app-name com.example.app`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+		preferUserId, _ := cmd.Flags().GetBool("prefer-user-id")
+
+		device := iterable.Device{}
+		device.Token, _ = cmd.Flags().GetString("token")
+		device.Platform, _ = cmd.Flags().GetString("platform")
+		device.ApplicationName, _ = cmd.Flags().GetString("app-name")
+
+		if (email == "") == (userId == "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+		if device.Token == "" {
+			return fmt.Errorf("--token is required")
+		}
+		if device.ApplicationName == "" {
+			return fmt.Errorf("--app-name is required")
+		}
+		if preferUserId && userId == "" {
+			return fmt.Errorf("--prefer-user-id requires --user-id")
+		}
+		if !slices.Contains(platforms, device.Platform) {
+			return fmt.Errorf("invalid platform %q, must be one of: %s", device.Platform, strings.Join(platforms, ", "))
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+		device.DataFields = dataFields
+
+		if err := client.RegisterDeviceToken(email, userId, preferUserId, device); err != nil {
+			return fmt.Errorf("error registering device: %v", err)
+		}
+
+		fmt.Println("Device registered successfully")
+		return nil
+	},
+}
+
+// RegisterBrowserCmd represents the register-browser command for devices
+var RegisterBrowserCmd = &cobra.Command{
+	Use:   "register-browser",
+	Short: "Register a web push token for a user",
+	Example: `iterablectl devices register-browser --email user@example.com --token https://fcm.googleapis.com/fcm/send/abc123
+iterablectl devices register-browser --user-id 42 --token abc123`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+		token, _ := cmd.Flags().GetString("token")
+
+		if (email == "") == (userId == "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+		if token == "" {
+			return fmt.Errorf("--token is required")
+		}
+
+		if err := client.RegisterBrowserToken(email, userId, token); err != nil {
+			return fmt.Errorf("error registering browser token: %v", err)
+		}
+
+		fmt.Println("Browser token registered successfully")
+		return nil
+	},
+}
+
+// DisableCmd represents the disable command for devices
+var DisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable push notifications for a token",
+	Example: `iterablectl devices disable --token abc123 --email user@example.com
+iterablectl devices disable --token abc123`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		token, _ := cmd.Flags().GetString("token")
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+
+		if token == "" {
+			return fmt.Errorf("--token is required")
+		}
+		if email != "" && userId != "" {
+			return fmt.Errorf("only one of --email or --user-id can be specified")
+		}
+
+		if err := client.DisableDevice(token, email, userId); err != nil {
+			return fmt.Errorf("error disabling device: %v", err)
+		}
+
+		fmt.Println("Device disabled successfully")
+		return nil
+	},
+}
+
+func init() {
+	RegisterCmd.Flags().String("email", "", "User email address")
+	RegisterCmd.Flags().String("user-id", "", "User ID")
+	RegisterCmd.Flags().String("token", "", "Push token")
+	RegisterCmd.Flags().String("platform", "", "Push platform: APNS, APNS_SANDBOX or GCM")
+	RegisterCmd.Flags().String("app-name", "", "Application name as configured in Iterable")
+	RegisterCmd.Flags().StringArray("data-field", []string{}, "Device data field in key=value format (can be used multiple times)")
+	RegisterCmd.Flags().String("data-file", "", "JSON file containing device data fields")
+	RegisterCmd.Flags().Bool("prefer-user-id", false, "Whether or not a new user should be created if the user ID doesn't yet exist in the Iterable project")
+
+	RegisterBrowserCmd.Flags().String("email", "", "User email address")
+	RegisterBrowserCmd.Flags().String("user-id", "", "User ID")
+	RegisterBrowserCmd.Flags().String("token", "", "Web push token")
+
+	DisableCmd.Flags().String("token", "", "Push token to disable")
+	DisableCmd.Flags().String("email", "", "Only disable the token for this email address")
+	DisableCmd.Flags().String("user-id", "", "Only disable the token for this user ID")
+}
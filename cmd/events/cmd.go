@@ -0,0 +1,17 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the events command
+var Cmd = &cobra.Command{
+	Use:   "events",
+	Short: "Manage Iterable events",
+}
+
+func init() {
+	Cmd.AddCommand(GetCmd)
+	Cmd.AddCommand(TrackCmd)
+	Cmd.AddCommand(TrackBulkCmd)
+}
@@ -0,0 +1,137 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// eventTimeLayout is the format Iterable uses for createdAt in event history
+const eventTimeLayout = "2006-01-02 15:04:05 -07:00"
+
+// standardEventFields are rendered in their own columns rather than as data fields
+var standardEventFields = map[string]bool{
+	"createdAt":    true,
+	"eventName":    true,
+	"eventType":    true,
+	"campaignId":   true,
+	"templateId":   true,
+	"email":        true,
+	"userId":       true,
+	"itblInternal": true,
+}
+
+// GetCmd represents the get command for events
+var GetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get a user's recent events",
+	Example: `iterablectl events get --email user@example.com
+iterablectl events get --user-id 42 --limit 100 --format ndjson`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		if (email == "" && userId == "") || (email != "" && userId != "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+
+		var events *[]iterable.UserEvent
+		var err error
+		if userId != "" {
+			events, err = client.GetUserEventsByID(userId, limit)
+		} else {
+			events, err = client.GetUserEvents(email, limit)
+		}
+		if err != nil {
+			return fmt.Errorf("error getting events: %v", err)
+		}
+
+		sort.SliceStable(*events, func(i, j int) bool {
+			return eventTime((*events)[i]).Before(eventTime((*events)[j]))
+		})
+
+		format, _ := cmd.Flags().GetString("format")
+		switch format {
+		case "json":
+			return utils.PrintJSON(events)
+		case "ndjson":
+			encoder := json.NewEncoder(os.Stdout)
+			for _, event := range *events {
+				if err := encoder.Encode(event); err != nil {
+					return fmt.Errorf("error formatting JSON: %v", err)
+				}
+			}
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TIME\tEVENT\tCAMPAIGN\tTEMPLATE\tDATA")
+		for _, event := range *events {
+			name := event["eventName"]
+			if name == nil {
+				name = event["eventType"]
+			}
+
+			dataFields := make(map[string]any)
+			for k, v := range event {
+				if !standardEventFields[k] {
+					dataFields[k] = v
+				}
+			}
+			data := ""
+			if len(dataFields) > 0 {
+				data = utils.FormatValue(dataFields)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				utils.FormatValue(event["createdAt"]),
+				utils.FormatValue(name),
+				formatOptional(event["campaignId"]),
+				formatOptional(event["templateId"]),
+				data,
+			)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// eventTime returns the time an event was created, or the zero time if it
+// cannot be parsed
+func eventTime(event iterable.UserEvent) time.Time {
+	s, _ := event["createdAt"].(string)
+	t, _ := time.Parse(eventTimeLayout, s)
+	return t
+}
+
+// formatOptional formats a value, rendering missing values as an empty string
+// and numbers such as IDs without exponent notation
+func formatOptional(v any) string {
+	if v == nil {
+		return ""
+	}
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return utils.FormatValue(v)
+}
+
+func init() {
+	GetCmd.Flags().String("email", "", "User email address")
+	GetCmd.Flags().String("user-id", "", "User ID")
+	GetCmd.Flags().Int("limit", 30, "Maximum number of events to return")
+	GetCmd.Flags().String("format", "table", "Output format: json, ndjson or table (default)")
+}
@@ -0,0 +1,71 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// TrackCmd represents the track command for events
+var TrackCmd = &cobra.Command{
+	Use:   "track",
+	Short: "Track a custom event for a user",
+	Example: `iterablectl events track --email user@example.com --name signedUp
+iterablectl events track --user-id 42 --name purchased --data-field plan=pro --created-at 2025-01-31`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+		name, _ := cmd.Flags().GetString("name")
+		createdAt, _ := cmd.Flags().GetString("created-at")
+
+		if (email == "" && userId == "") || (email != "" && userId != "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+		if name == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+
+		event := iterable.Event{
+			Email:      email,
+			UserID:     userId,
+			EventName:  name,
+			DataFields: dataFields,
+		}
+
+		if createdAt != "" {
+			t, err := utils.ParseTime(createdAt)
+			if err != nil {
+				return err
+			}
+			event.CreatedAt = t.Unix()
+		}
+
+		if err := client.TrackEvent(event); err != nil {
+			return fmt.Errorf("error tracking event: %v", err)
+		}
+
+		fmt.Printf("Event '%s' tracked successfully\n", name)
+		return nil
+	},
+}
+
+func init() {
+	TrackCmd.Flags().String("email", "", "User email address")
+	TrackCmd.Flags().String("user-id", "", "User ID")
+	TrackCmd.Flags().String("name", "", "Event name")
+	TrackCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	TrackCmd.Flags().String("data-file", "", "JSON file containing data fields")
+	TrackCmd.Flags().String("created-at", "", "Time the event happened (RFC 3339, YYYY-MM-DD or unix seconds), defaults to now")
+}
@@ -0,0 +1,127 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// TrackBulkCmd represents the track-bulk command for events
+var TrackBulkCmd = &cobra.Command{
+	Use:     "track-bulk",
+	Short:   "Track custom events from an NDJSON file",
+	Example: `iterablectl events track-bulk --file events.ndjson`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		file, _ := cmd.Flags().GetString("file")
+		batchSize, _ := cmd.Flags().GetInt("batch-size")
+
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if batchSize <= 0 {
+			return fmt.Errorf("--batch-size must be greater than zero")
+		}
+
+		events, lines, err := readEvents(file)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return fmt.Errorf("no events found in %s", file)
+		}
+
+		var succeeded, failed int
+		for start := 0; start < len(events); start += batchSize {
+			end := min(start+batchSize, len(events))
+			batch := events[start:end]
+
+			response, err := client.TrackEvents(batch)
+			if err != nil {
+				return fmt.Errorf("error tracking events on lines %d-%d: %v", lines[start], lines[end-1], err)
+			}
+
+			succeeded += response.SuccessCount
+			failed += response.FailCount
+			reportFailures(batch, lines[start:end], response)
+		}
+
+		fmt.Printf("Tracked %d events (%d failed)\n", succeeded, failed)
+		return nil
+	},
+}
+
+// readEvents parses one event per line, returning the events along with
+// their line numbers in the file
+func readEvents(path string) ([]iterable.Event, []int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	defer f.Close()
+
+	var events []iterable.Event
+	var lines []int
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var event iterable.Event
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid event: %v", n, err)
+		}
+		if event.EventName == "" {
+			return nil, nil, fmt.Errorf("line %d: eventName is required", n)
+		}
+		if event.Email == "" && event.UserID == "" {
+			return nil, nil, fmt.Errorf("line %d: email or userId is required", n)
+		}
+
+		events = append(events, event)
+		lines = append(lines, n)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	return events, lines, nil
+}
+
+// reportFailures prints the file line of each event rejected by the API
+func reportFailures(events []iterable.Event, lines []int, response *iterable.BulkTrackResponse) {
+	invalid := make(map[string]string)
+	for _, email := range response.InvalidEmails {
+		invalid["email:"+email] = "invalid email"
+	}
+	for _, userID := range response.InvalidUserIds {
+		invalid["userId:"+userID] = "invalid userId"
+	}
+	for _, name := range response.DisallowedEventNames {
+		invalid["eventName:"+name] = "disallowed event name"
+	}
+
+	for i, event := range events {
+		for _, key := range []string{"email:" + event.Email, "userId:" + event.UserID, "eventName:" + event.EventName} {
+			if reason, ok := invalid[key]; ok {
+				fmt.Fprintf(os.Stderr, "line %d: %s\n", lines[i], reason)
+				break
+			}
+		}
+	}
+}
+
+func init() {
+	TrackBulkCmd.Flags().String("file", "", "NDJSON file with one event object per line")
+	TrackBulkCmd.Flags().Int("batch-size", 500, "Number of events to track per request")
+}
@@ -0,0 +1,15 @@
+package experiments
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the experiments command
+var Cmd = &cobra.Command{
+	Use:   "experiments",
+	Short: "Inspect Iterable A/B experiments",
+}
+
+func init() {
+	Cmd.AddCommand(MetricsCmd)
+}
@@ -0,0 +1,194 @@
+package experiments
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// variantResult is a variant's metrics compared with its experiment's control
+type variantResult struct {
+	iterable.ExperimentVariant
+	Base        float64  `json:"base"`
+	Conversions float64  `json:"conversions"`
+	Rate        float64  `json:"rate"`
+	Lift        *float64 `json:"lift,omitempty"`
+	PValue      *float64 `json:"pValue,omitempty"`
+	Significant bool     `json:"significant"`
+}
+
+// MetricsCmd represents the metrics command for experiments
+var MetricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Get A/B experiment metrics with lift and significance per variant",
+	Long: `Get A/B experiment metrics with lift and significance per variant.
+
+The conversion rate of each variant is --metric divided by --base. Lift is the
+relative change of that rate against the experiment's control variant, which is
+the variant marked as control in the report or else the first variant listed.
+Significance uses a two-sided two-proportion z-test.`,
+	Example: `iterablectl experiments metrics --experiment-id 1234
+iterablectl experiments metrics --campaign-id 5678 --start 2025-01-01 --end 2025-02-01
+iterablectl experiments metrics --experiment-id 1234 --metric "Total Purchases" --format json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		opts := iterable.ExperimentMetricsOpts{}
+		opts.ExperimentIDs, _ = cmd.Flags().GetIntSlice("experiment-id")
+		opts.CampaignIDs, _ = cmd.Flags().GetIntSlice("campaign-id")
+		metric, _ := cmd.Flags().GetString("metric")
+		base, _ := cmd.Flags().GetString("base")
+		confidence, _ := cmd.Flags().GetFloat64("confidence")
+
+		if len(opts.ExperimentIDs) == 0 && len(opts.CampaignIDs) == 0 {
+			return fmt.Errorf("at least one of --experiment-id or --campaign-id must be specified")
+		}
+		if confidence <= 0 || confidence >= 1 {
+			return fmt.Errorf("--confidence must be between 0 and 1")
+		}
+
+		var err error
+		if s, _ := cmd.Flags().GetString("start"); s != "" {
+			if opts.Start, err = utils.ParseTime(s); err != nil {
+				return err
+			}
+		}
+		if s, _ := cmd.Flags().GetString("end"); s != "" {
+			if opts.End, err = utils.ParseTime(s); err != nil {
+				return err
+			}
+		}
+
+		metrics, err := client.GetExperimentMetrics(opts)
+		if err != nil {
+			return fmt.Errorf("error getting experiment metrics: %v", err)
+		}
+
+		variants, err := iterable.ExperimentVariants(metrics)
+		if err != nil {
+			return fmt.Errorf("error parsing experiment metrics: %v", err)
+		}
+
+		results, err := compareVariants(variants, metric, base, 1-confidence)
+		if err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(results)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "EXPERIMENT\tTEMPLATE\tVARIANT\tBASE\tCONVERSIONS\tRATE\tLIFT\tP-VALUE\tSIGNIFICANT")
+		for _, result := range results {
+			name := result.Name
+			if result.Control {
+				name += " (control)"
+			}
+			lift, pValue, significant := "-", "-", "-"
+			if result.Lift != nil {
+				lift = fmt.Sprintf("%+.2f%%", *result.Lift*100)
+			}
+			if result.PValue != nil {
+				pValue = fmt.Sprintf("%.4f", *result.PValue)
+				significant = strconv.FormatBool(result.Significant)
+			}
+			fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%.2f%%\t%s\t%s\t%s\n",
+				result.ExperimentID, result.TemplateID, name,
+				formatCount(result.Base), formatCount(result.Conversions),
+				result.Rate*100, lift, pValue, significant)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// compareVariants computes the conversion rate of each variant and its lift
+// and significance against the control of its experiment
+func compareVariants(variants []iterable.ExperimentVariant, metric, base string, alpha float64) ([]variantResult, error) {
+	results := make([]variantResult, 0, len(variants))
+	controls := map[int]int{}
+
+	for _, variant := range variants {
+		conversions, ok := variant.Metric(metric)
+		if !ok {
+			return nil, fmt.Errorf("metric %q not found in experiment report", metric)
+		}
+		total, ok := variant.Metric(base)
+		if !ok {
+			return nil, fmt.Errorf("metric %q not found in experiment report", base)
+		}
+
+		result := variantResult{ExperimentVariant: variant, Base: total, Conversions: conversions}
+		if total > 0 {
+			result.Rate = conversions / total
+		}
+		results = append(results, result)
+
+		if _, found := controls[variant.ExperimentID]; !found || variant.Control {
+			controls[variant.ExperimentID] = len(results) - 1
+		}
+	}
+
+	for i := range results {
+		control := results[controls[results[i].ExperimentID]]
+		if i == controls[results[i].ExperimentID] {
+			results[i].Control = true
+			continue
+		}
+		results[i].Control = false
+
+		if control.Rate > 0 {
+			lift := (results[i].Rate - control.Rate) / control.Rate
+			results[i].Lift = &lift
+		}
+		if pValue, ok := twoProportionPValue(control.Conversions, control.Base, results[i].Conversions, results[i].Base); ok {
+			results[i].PValue = &pValue
+			results[i].Significant = pValue < alpha
+		}
+	}
+
+	return results, nil
+}
+
+// formatCount formats a metric count without exponent notation
+func formatCount(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// twoProportionPValue returns the two-sided p-value of a two-proportion
+// z-test, or false when the samples are too small to compare
+func twoProportionPValue(x1, n1, x2, n2 float64) (float64, bool) {
+	if n1 <= 0 || n2 <= 0 {
+		return 0, false
+	}
+
+	pooled := (x1 + x2) / (n1 + n2)
+	stderr := math.Sqrt(pooled * (1 - pooled) * (1/n1 + 1/n2))
+	if stderr == 0 {
+		return 0, false
+	}
+
+	z := (x2/n2 - x1/n1) / stderr
+	return math.Erfc(math.Abs(z) / math.Sqrt2), true
+}
+
+func init() {
+	MetricsCmd.Flags().IntSlice("experiment-id", []int{}, "Experiment ID (can be used multiple times)")
+	MetricsCmd.Flags().IntSlice("campaign-id", []int{}, "Campaign ID whose experiments to include (can be used multiple times)")
+	MetricsCmd.Flags().String("start", "", "Start of the date range (RFC 3339, YYYY-MM-DD or unix seconds)")
+	MetricsCmd.Flags().String("end", "", "End of the date range (RFC 3339, YYYY-MM-DD or unix seconds)")
+	MetricsCmd.Flags().String("metric", "Unique Email Clicks", "Metric counting conversions")
+	MetricsCmd.Flags().String("base", "Total Email Sends", "Metric counting the audience conversions are measured against")
+	MetricsCmd.Flags().Float64("confidence", 0.95, "Confidence level for significance")
+	MetricsCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
@@ -0,0 +1,86 @@
+package experiments
+
+import (
+	"math"
+	"testing"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+)
+
+func TestTwoProportionPValue(t *testing.T) {
+	tests := []struct {
+		name           string
+		x1, n1, x2, n2 float64
+		want           float64
+		wantOK         bool
+	}{
+		{"clear difference", 50, 1000, 80, 1000, 0.006507, true},
+		{"no difference", 50, 1000, 50, 1000, 1, true},
+		{"symmetric", 80, 1000, 50, 1000, 0.006507, true},
+		{"empty sample", 0, 0, 10, 100, 0, false},
+		{"no conversions at all", 0, 100, 0, 100, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := twoProportionPValue(tt.x1, tt.n1, tt.x2, tt.n2)
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %v, want %v", ok, tt.wantOK)
+			}
+			if ok && math.Abs(got-tt.want) > 1e-5 {
+				t.Errorf("got p-value %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareVariants(t *testing.T) {
+	variant := func(experimentID int, name string, control bool, sends, clicks float64) iterable.ExperimentVariant {
+		return iterable.ExperimentVariant{
+			ExperimentID: experimentID,
+			Name:         name,
+			Control:      control,
+			Metrics:      map[string]float64{"Total Email Sends": sends, "Unique Email Clicks": clicks},
+		}
+	}
+
+	t.Run("lift against the marked control", func(t *testing.T) {
+		variants := []iterable.ExperimentVariant{
+			variant(1, "B", false, 1000, 80),
+			variant(1, "A", true, 1000, 50),
+			variant(2, "C", false, 500, 10),
+			variant(2, "D", false, 500, 10),
+		}
+
+		results, err := compareVariants(variants, "uniqueEmailClicks", "Total Email Sends", 0.05)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, a, c, d := results[0], results[1], results[2], results[3]
+		if !a.Control || b.Control || a.Lift != nil {
+			t.Errorf("expected A to be the only control of experiment 1")
+		}
+		if b.Lift == nil || math.Abs(*b.Lift-0.6) > 1e-9 {
+			t.Errorf("got lift %v, want 0.6", b.Lift)
+		}
+		if !b.Significant {
+			t.Errorf("expected B to be significant")
+		}
+
+		// Without a marked control, the first variant is the control
+		if !c.Control || d.Control {
+			t.Errorf("expected C to be the control of experiment 2")
+		}
+		if d.Lift == nil || *d.Lift != 0 || d.Significant {
+			t.Errorf("got lift %v significant %v, want 0 and not significant", d.Lift, d.Significant)
+		}
+	})
+
+	t.Run("missing metric", func(t *testing.T) {
+		variants := []iterable.ExperimentVariant{variant(1, "A", true, 1000, 50)}
+		if _, err := compareVariants(variants, "Total Purchases", "Total Email Sends", 0.05); err == nil {
+			t.Errorf("expected an error for a missing metric")
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package export
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the export command
+var Cmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data from Iterable",
+}
+
+func init() {
+	Cmd.AddCommand(DataCmd)
+	Cmd.AddCommand(StartCmd)
+	Cmd.AddCommand(StatusCmd)
+	Cmd.AddCommand(WaitCmd)
+	Cmd.AddCommand(DownloadCmd)
+}
@@ -0,0 +1,211 @@
+package export
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+)
+
+const manifestFile = "manifest.json"
+
+// manifest records the files downloaded for an export job
+type manifest struct {
+	JobID int                      `json:"jobId"`
+	Files map[string]manifestEntry `json:"files"`
+}
+
+// manifestEntry records a downloaded file
+type manifestEntry struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// loadManifest reads the manifest in dir, returning an empty manifest if
+// there is none
+func loadManifest(dir string, jobID int) (*manifest, error) {
+	m := &manifest{JobID: jobID, Files: make(map[string]manifestEntry)}
+
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %v", err)
+	}
+
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %v", err)
+	}
+	if m.JobID != jobID {
+		return nil, fmt.Errorf("%s belongs to export job %d, use a different directory", filepath.Join(dir, manifestFile), m.JobID)
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]manifestEntry)
+	}
+
+	return m, nil
+}
+
+// save writes the manifest to dir
+func (m *manifest) save(dir string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, manifestFile), data, 0644)
+}
+
+// downloadFiles downloads the files of an export job to dir, skipping files
+// already recorded in the manifest with a matching checksum
+func downloadFiles(client *iterable.Client, jobID int, dir string, concurrency int) error {
+	if concurrency <= 0 {
+		return fmt.Errorf("--concurrency must be greater than zero")
+	}
+
+	files, err := client.GetExportFiles(jobID)
+	if err != nil {
+		return fmt.Errorf("error getting export files: %v", err)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	m, err := loadManifest(dir, jobID)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	var downloaded, skipped int
+
+	jobs := make(chan iterable.ExportFile)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range jobs {
+				mu.Lock()
+				entry, ok := m.Files[file.File]
+				mu.Unlock()
+
+				path, err := localPath(dir, file.File)
+				if err == nil && ok && verifyFile(path, entry) {
+					mu.Lock()
+					skipped++
+					mu.Unlock()
+					continue
+				}
+
+				if err == nil {
+					entry, err = downloadFile(client, file.URL, path)
+				}
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %v", file.File, err))
+				} else {
+					m.Files[file.File] = entry
+					downloaded++
+					if err := m.save(dir); err != nil {
+						errs = append(errs, fmt.Errorf("failed to save manifest: %v", err))
+					}
+					fmt.Fprintf(os.Stderr, "Downloaded %s (%d bytes)\n", file.File, entry.Size)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, file := range files {
+		jobs <- file
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	fmt.Printf("Downloaded %d files, skipped %d already downloaded, %d failed\n", downloaded, skipped, len(errs))
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to download %d files, run the command again to resume", len(errs))
+	}
+
+	return nil
+}
+
+// localPath returns the path to download an export file to, rejecting
+// names that would escape dir
+func localPath(dir, name string) (string, error) {
+	rel := filepath.FromSlash(name)
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("invalid file name")
+	}
+	return filepath.Join(dir, rel), nil
+}
+
+// downloadFile downloads a file to path through a temporary file, returning
+// its size and checksum
+func downloadFile(client *iterable.Client, url, path string) (manifestEntry, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return manifestEntry{}, err
+	}
+
+	tmp := path + ".part"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return manifestEntry{}, err
+	}
+	defer os.Remove(tmp)
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	err = client.Download(url, io.MultiWriter(f, hash, counter))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return manifestEntry{}, err
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return manifestEntry{}, err
+	}
+
+	return manifestEntry{Size: counter.n, SHA256: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+// verifyFile reports whether the file at path matches a manifest entry
+func verifyFile(path string, entry manifestEntry) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	n, err := io.Copy(hash, f)
+	if err != nil {
+		return false
+	}
+
+	return n == entry.Size && hex.EncodeToString(hash.Sum(nil)) == entry.SHA256
+}
+
+// countingWriter counts the bytes written to it
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,227 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// StartCmd represents the start command for export
+var StartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start an asynchronous export job",
+	Example: `iterablectl export start --type emailSend --start 2024-01-01 --end 2025-01-01
+iterablectl export start --type user --start 2024-01-01 --format json --dir ./export`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		opts, err := parseExportOpts(cmd)
+		if err != nil {
+			return err
+		}
+
+		// Downloading the files requires waiting for the job
+		wait, _ := cmd.Flags().GetBool("wait")
+		wait = wait || cmd.Flags().Changed("dir")
+		if wait {
+			if err := checkWaitFlags(cmd); err != nil {
+				return err
+			}
+		}
+
+		jobID, err := client.StartExport(opts)
+		if err != nil {
+			return fmt.Errorf("error starting export: %v", err)
+		}
+		fmt.Printf("Export job %d started\n", jobID)
+
+		if !wait {
+			return nil
+		}
+		return waitAndDownload(cmd, client, jobID)
+	},
+}
+
+// StatusCmd represents the status command for export
+var StatusCmd = &cobra.Command{
+	Use:     "status <jobId>",
+	Short:   "Show the status of an export job",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl export status 12345",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		jobID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid jobId: %s", args[0])
+		}
+
+		job, err := client.GetExportJob(jobID)
+		if err != nil {
+			return fmt.Errorf("error getting export job: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(job)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "Job ID\t%d\n", job.ID)
+		fmt.Fprintf(w, "State\t%s\n", job.State)
+		fmt.Fprintf(w, "Data Type\t%s\n", job.DataTypeName)
+		fmt.Fprintf(w, "Bytes Exported\t%d\n", job.BytesExported)
+		fmt.Fprintf(w, "Scheduled Start\t%s\n", job.ScheduledStartTime)
+		fmt.Fprintf(w, "Started\t%s\n", job.StartTime)
+		fmt.Fprintf(w, "Ended\t%s\n", job.EndTime)
+		if job.Error != "" {
+			fmt.Fprintf(w, "Error\t%s\n", job.Error)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// WaitCmd represents the wait command for export
+var WaitCmd = &cobra.Command{
+	Use:   "wait <jobId>",
+	Short: "Wait for an export job to finish",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl export wait 12345
+iterablectl export wait 12345 --timeout 2h --dir ./export`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		jobID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid jobId: %s", args[0])
+		}
+
+		return waitAndDownload(cmd, client, jobID)
+	},
+}
+
+// DownloadCmd represents the download command for export
+var DownloadCmd = &cobra.Command{
+	Use:   "download <jobId>",
+	Short: "Download the files of a completed export job",
+	Long: `Download the files of a completed export job.
+
+Files are downloaded concurrently and recorded with their size and SHA-256
+checksum in manifest.json in the output directory. Running the command again
+skips files that match the manifest, so interrupted downloads can be resumed.`,
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl export download 12345 --dir ./export --concurrency 8",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		jobID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid jobId: %s", args[0])
+		}
+
+		dir, _ := cmd.Flags().GetString("dir")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		if dir == "" {
+			return fmt.Errorf("--dir is required")
+		}
+
+		return downloadFiles(client, jobID, dir, concurrency)
+	},
+}
+
+// checkWaitFlags validates the flags controlling how a job is polled
+func checkWaitFlags(cmd *cobra.Command) error {
+	interval, _ := cmd.Flags().GetDuration("interval")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be greater than zero")
+	}
+	if timeout < 0 {
+		return fmt.Errorf("--timeout cannot be negative")
+	}
+	return nil
+}
+
+// waitAndDownload polls a job until it finishes, then downloads its files if
+// --dir is set
+func waitAndDownload(cmd *cobra.Command, client *iterable.Client, jobID int) error {
+	if err := checkWaitFlags(cmd); err != nil {
+		return err
+	}
+	interval, _ := cmd.Flags().GetDuration("interval")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	dir, _ := cmd.Flags().GetString("dir")
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+	job, err := waitForJob(client, jobID, interval, timeout)
+	if err != nil {
+		return err
+	}
+	if job.State != "completed" {
+		return fmt.Errorf("export job %d %s: %s", jobID, job.State, job.Error)
+	}
+	fmt.Printf("Export job %d completed, %d bytes exported\n", jobID, job.BytesExported)
+
+	if dir == "" {
+		return nil
+	}
+	return downloadFiles(client, jobID, dir, concurrency)
+}
+
+// waitForJob polls a job with exponential backoff until it is done or the
+// timeout expires. A zero timeout waits indefinitely.
+func waitForJob(client *iterable.Client, jobID int, interval, timeout time.Duration) (*iterable.ExportJob, error) {
+	const maxInterval = time.Minute
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		job, err := client.GetExportJob(jobID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting export job: %v", err)
+		}
+		if job.Done() {
+			return job, nil
+		}
+
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for export job %d, last state: %s", jobID, job.State)
+		}
+		fmt.Fprintf(os.Stderr, "Export job %d is %s, checking again in %s\n", jobID, job.State, interval)
+		time.Sleep(interval)
+
+		interval = min(interval*3/2, maxInterval)
+	}
+}
+
+func init() {
+	addExportFlags(StartCmd)
+	StartCmd.Flags().Bool("wait", false, "Wait for the job to finish")
+
+	StatusCmd.Flags().String("format", "table", "Output format: json or table (default)")
+
+	for _, cmd := range []*cobra.Command{StartCmd, WaitCmd} {
+		cmd.Flags().Duration("interval", 2*time.Second, "Initial interval between status checks, increased up to a minute")
+		cmd.Flags().Duration("timeout", 0, "Maximum time to wait, no limit if zero")
+		cmd.Flags().String("dir", "", "Download the files to this directory once the job completes (implies --wait for start)")
+		cmd.Flags().Int("concurrency", 4, "Number of files to download at once")
+	}
+
+	DownloadCmd.Flags().String("dir", "", "Directory to download the files to")
+	DownloadCmd.Flags().Int("concurrency", 4, "Number of files to download at once")
+}
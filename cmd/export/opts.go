@@ -0,0 +1,59 @@
+package export
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// addExportFlags adds the flags describing what data to export
+func addExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("type", "", "Data type to export, e.g. user, emailSend, emailOpen, emailClick, emailBounce, smsSend, pushSend, purchase, customEvent")
+	cmd.Flags().String("start", "", "Start of the date range (RFC 3339, YYYY-MM-DD or unix seconds)")
+	cmd.Flags().String("end", "", "End of the date range (RFC 3339, YYYY-MM-DD or unix seconds), defaults to now")
+	cmd.Flags().String("format", "csv", "Output format: csv or json (newline-delimited)")
+	cmd.Flags().StringSlice("only-fields", []string{}, "Only export these fields (comma-separated)")
+	cmd.Flags().StringSlice("omit-fields", []string{}, "Omit these fields from the export (comma-separated)")
+	cmd.Flags().Int("campaign-id", 0, "Only export data for this campaign")
+}
+
+// parseExportOpts reads and validates the flags added by addExportFlags
+func parseExportOpts(cmd *cobra.Command) (iterable.ExportDataOpts, error) {
+	opts := iterable.ExportDataOpts{}
+	opts.DataType, _ = cmd.Flags().GetString("type")
+	opts.Format, _ = cmd.Flags().GetString("format")
+	opts.OnlyFields, _ = cmd.Flags().GetStringSlice("only-fields")
+	opts.OmitFields, _ = cmd.Flags().GetStringSlice("omit-fields")
+	opts.CampaignID, _ = cmd.Flags().GetInt("campaign-id")
+	startStr, _ := cmd.Flags().GetString("start")
+	endStr, _ := cmd.Flags().GetString("end")
+
+	if opts.DataType == "" {
+		return opts, fmt.Errorf("--type is required")
+	}
+	if startStr == "" {
+		return opts, fmt.Errorf("--start is required")
+	}
+	if len(opts.OnlyFields) > 0 && len(opts.OmitFields) > 0 {
+		return opts, fmt.Errorf("--only-fields and --omit-fields cannot be used together")
+	}
+
+	var err error
+	if opts.Start, err = utils.ParseTime(startStr); err != nil {
+		return opts, err
+	}
+	opts.End = time.Now()
+	if endStr != "" {
+		if opts.End, err = utils.ParseTime(endStr); err != nil {
+			return opts, err
+		}
+	}
+	if !opts.End.After(opts.Start) {
+		return opts, fmt.Errorf("--end must be after --start")
+	}
+
+	return opts, nil
+}
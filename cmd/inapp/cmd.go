@@ -0,0 +1,17 @@
+package inapp
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the inapp command
+var Cmd = &cobra.Command{
+	Use:   "inapp",
+	Short: "Inspect and clean up in-app message inboxes",
+}
+
+func init() {
+	Cmd.AddCommand(MessagesCmd)
+	Cmd.AddCommand(ConsumeCmd)
+	Cmd.AddCommand(DeleteCmd)
+}
@@ -0,0 +1,57 @@
+package inapp
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// ConsumeCmd represents the consume command for inapp
+var ConsumeCmd = &cobra.Command{
+	Use:     "consume <messageId>",
+	Short:   "Consume an in-app message, removing it from the user's queue",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl inapp consume abc123 --email user@example.com",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMessageAction(cmd, args[0], "consumed", (*iterable.Client).ConsumeInAppMessage)
+	},
+}
+
+// DeleteCmd represents the delete command for inapp
+var DeleteCmd = &cobra.Command{
+	Use:     "delete <messageId>",
+	Short:   "Delete an in-app message from the user's inbox",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl inapp delete abc123 --user-id 42",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMessageAction(cmd, args[0], "deleted", (*iterable.Client).DeleteInAppMessage)
+	},
+}
+
+// runMessageAction applies an action to a user's in-app message
+func runMessageAction(cmd *cobra.Command, messageID, done string, action func(*iterable.Client, string, string, string) error) error {
+	apiKey, _ := cmd.Flags().GetString("api-key")
+	client := iterable.NewClient(apiKey)
+
+	email, _ := cmd.Flags().GetString("email")
+	userId, _ := cmd.Flags().GetString("user-id")
+
+	if (email == "") == (userId == "") {
+		return fmt.Errorf("exactly one of --email or --user-id must be specified")
+	}
+
+	if err := action(client, email, userId, messageID); err != nil {
+		return fmt.Errorf("error updating in-app message: %v", err)
+	}
+
+	fmt.Printf("Message '%s' successfully %s\n", messageID, done)
+	return nil
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{ConsumeCmd, DeleteCmd} {
+		cmd.Flags().String("email", "", "User email address")
+		cmd.Flags().String("user-id", "", "User ID")
+	}
+}
@@ -0,0 +1,70 @@
+package inapp
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// MessagesCmd represents the messages command for inapp
+var MessagesCmd = &cobra.Command{
+	Use:   "messages",
+	Short: "List the in-app messages queued for a user",
+	Example: `iterablectl inapp messages --email user@example.com
+iterablectl inapp messages --user-id 42 --platform iOS --sdk-version 6.4.0 --count 50`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		opts := iterable.GetInAppMessagesOpts{}
+		opts.Email, _ = cmd.Flags().GetString("email")
+		opts.UserID, _ = cmd.Flags().GetString("user-id")
+		opts.Count, _ = cmd.Flags().GetInt("count")
+		opts.Platform, _ = cmd.Flags().GetString("platform")
+		opts.SDKVersion, _ = cmd.Flags().GetString("sdk-version")
+
+		if (opts.Email == "") == (opts.UserID == "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+
+		messages, err := client.GetInAppMessages(opts)
+		if err != nil {
+			return fmt.Errorf("error getting in-app messages: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(messages)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MESSAGE ID\tCAMPAIGN\tCREATED\tEXPIRES\tREAD\tINBOX\tPRIORITY")
+		for _, message := range *messages {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%t\t%t\t%s\n",
+				message.MessageID,
+				message.CampaignID,
+				utils.FormatMillis(message.CreatedAt),
+				utils.FormatMillis(message.ExpiresAt),
+				message.Read,
+				message.SaveToInbox,
+				utils.FormatValue(message.PriorityLevel),
+			)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	MessagesCmd.Flags().String("email", "", "User email address")
+	MessagesCmd.Flags().String("user-id", "", "User ID")
+	MessagesCmd.Flags().Int("count", 100, "Maximum number of messages to return")
+	MessagesCmd.Flags().String("platform", "", "Platform to fetch messages for: iOS, Android or Web")
+	MessagesCmd.Flags().String("sdk-version", "", "SDK version to fetch messages for, affects which messages are returned")
+	MessagesCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
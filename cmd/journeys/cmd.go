@@ -0,0 +1,17 @@
+package journeys
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the journeys command
+var Cmd = &cobra.Command{
+	Use:   "journeys",
+	Short: "Manage Iterable journeys",
+}
+
+func init() {
+	Cmd.AddCommand(ListCmd)
+	Cmd.AddCommand(TriggerCmd)
+	Cmd.AddCommand(CampaignsCmd)
+}
@@ -0,0 +1,102 @@
+package journeys
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd represents the list command for journeys
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List journeys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		journeys, err := client.GetJourneys()
+		if err != nil {
+			return fmt.Errorf("error getting journeys: %v", err)
+		}
+
+		archived, _ := cmd.Flags().GetBool("archived")
+		filtered := make([]iterable.Journey, 0, len(*journeys))
+		for _, journey := range *journeys {
+			if journey.IsArchived && !archived {
+				continue
+			}
+			filtered = append(filtered, journey)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(filtered)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, journey := range filtered {
+			state := "disabled"
+			if journey.Enabled {
+				state = "enabled"
+			}
+			updatedAt := utils.FormatMillis(journey.UpdatedAt)
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", journey.ID, journey.Name, state, journey.JourneyType, updatedAt)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// CampaignsCmd represents the campaigns command for journeys
+var CampaignsCmd = &cobra.Command{
+	Use:     "campaigns <workflowId>",
+	Short:   "List the campaigns sent by a journey",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl journeys campaigns 1234",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		workflowID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid workflowId: %s", args[0])
+		}
+
+		campaigns, err := client.GetCampaigns()
+		if err != nil {
+			return fmt.Errorf("error getting campaigns: %v", err)
+		}
+
+		filtered := make([]iterable.Campaign, 0)
+		for _, campaign := range *campaigns {
+			if campaign.WorkflowId == workflowID {
+				filtered = append(filtered, campaign)
+			}
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(filtered)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, campaign := range filtered {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", campaign.ID, campaign.Name, campaign.CampaignState, campaign.MessageMedium)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	ListCmd.Flags().Bool("archived", false, "Include archived journeys")
+	ListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+	CampaignsCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
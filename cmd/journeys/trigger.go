@@ -0,0 +1,83 @@
+package journeys
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// TriggerCmd represents the trigger command for journeys
+var TriggerCmd = &cobra.Command{
+	Use:   "trigger <workflowId>",
+	Short: "Trigger an API-entry journey for a user or list",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl journeys trigger 1234 --email user@example.com --data-file payload.json
+iterablectl journeys trigger 1234 --list-id 56`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		workflowID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid workflowId: %s", args[0])
+		}
+
+		trigger := iterable.TriggerWorkflowRequest{WorkflowID: workflowID}
+		trigger.Email, _ = cmd.Flags().GetString("email")
+		trigger.UserID, _ = cmd.Flags().GetString("user-id")
+		trigger.ListID, _ = cmd.Flags().GetInt("list-id")
+
+		given := 0
+		if trigger.Email != "" {
+			given++
+		}
+		if trigger.UserID != "" {
+			given++
+		}
+		if trigger.ListID != 0 {
+			given++
+		}
+		if given != 1 {
+			return fmt.Errorf("exactly one of --email, --user-id or --list-id must be specified")
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+		trigger.DataFields = dataFields
+
+		// Entering a whole list into a journey cannot be undone
+		if yes, _ := cmd.Flags().GetBool("yes"); trigger.ListID != 0 && !yes {
+			ok, err := utils.Confirm(fmt.Sprintf("Enter every user in list %d into journey %d?", trigger.ListID, workflowID))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted")
+				return nil
+			}
+		}
+
+		if err := client.TriggerWorkflow(trigger); err != nil {
+			return fmt.Errorf("error triggering journey: %v", err)
+		}
+
+		fmt.Printf("Journey %d triggered successfully\n", workflowID)
+		return nil
+	},
+}
+
+func init() {
+	TriggerCmd.Flags().String("email", "", "Email address of the user to enter into the journey")
+	TriggerCmd.Flags().String("user-id", "", "User ID of the user to enter into the journey")
+	TriggerCmd.Flags().Int("list-id", 0, "List ID whose users enter the journey")
+	TriggerCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	TriggerCmd.Flags().String("data-file", "", "JSON file containing data fields")
+	TriggerCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt for --list-id")
+}
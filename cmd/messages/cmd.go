@@ -0,0 +1,15 @@
+package messages
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the messages command
+var Cmd = &cobra.Command{
+	Use:   "messages",
+	Short: "View messages sent to users",
+}
+
+func init() {
+	Cmd.AddCommand(ViewCmd)
+}
@@ -0,0 +1,133 @@
+package messages
+
+import (
+	"fmt"
+	"html"
+	"os"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ViewCmd represents the view command for messages
+var ViewCmd = &cobra.Command{
+	Use:   "view <messageId>",
+	Short: "View the content of a message sent to a user",
+	Long: `View the content of a message sent to a user.
+
+Emails are shown exactly as the user received them. Iterable does not keep
+the rendered content of SMS and push messages, so for those the template of
+the message's campaign is shown without merge fields filled in.`,
+	Args: cobra.ExactArgs(1),
+	Example: `iterablectl messages view abc123 --email user@example.com --out message.html
+iterablectl messages view abc123 --email user@example.com --open
+iterablectl messages view abc123 --email user@example.com --medium sms`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		messageID := args[0]
+		email, _ := cmd.Flags().GetString("email")
+		medium, _ := cmd.Flags().GetString("medium")
+		out, _ := cmd.Flags().GetString("out")
+		open, _ := cmd.Flags().GetBool("open")
+
+		if email == "" {
+			return fmt.Errorf("--email is required")
+		}
+
+		var content []byte
+		var err error
+		switch medium {
+		case "email":
+			content, err = client.ViewEmail(email, messageID)
+			if err != nil {
+				return fmt.Errorf("error viewing email: %v", err)
+			}
+		case "sms", "push":
+			content, err = templateContent(client, email, messageID, medium)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid medium %q, must be one of: email, sms, push", medium)
+		}
+
+		if open {
+			if out == "" {
+				f, err := os.CreateTemp("", "iterable-message-*.html")
+				if err != nil {
+					return fmt.Errorf("failed to create temporary file: %v", err)
+				}
+				f.Close()
+				out = f.Name()
+			}
+			if medium != "email" {
+				content = []byte("<pre>" + html.EscapeString(string(content)) + "</pre>")
+			}
+		}
+
+		if out == "" {
+			fmt.Println(string(content))
+			return nil
+		}
+
+		if err := os.WriteFile(out, content, 0644); err != nil {
+			return fmt.Errorf("failed to write message: %v", err)
+		}
+		fmt.Printf("Message written to %s\n", out)
+
+		if open {
+			if err := utils.OpenBrowser(out); err != nil {
+				return fmt.Errorf("failed to open browser: %v", err)
+			}
+		}
+
+		return nil
+	},
+}
+
+// templateContent finds a sent message and returns the content of its
+// campaign's template
+func templateContent(client *iterable.Client, email, messageID, medium string) ([]byte, error) {
+	messages, err := client.GetSentMessages(iterable.GetSentMessagesOpts{Email: email, Limit: 1000})
+	if err != nil {
+		return nil, fmt.Errorf("error getting sent messages: %v", err)
+	}
+
+	var message *iterable.SentMessage
+	for i := range *messages {
+		if (*messages)[i].MessageID == messageID {
+			message = &(*messages)[i]
+			break
+		}
+	}
+	if message == nil {
+		return nil, fmt.Errorf("message %s not found in the messages sent to %s", messageID, email)
+	}
+
+	templateID := message.TemplateID
+	if templateID == 0 {
+		campaign, err := client.GetCampaign(message.CampaignID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting campaign: %v", err)
+		}
+		templateID = campaign.TemplateId
+	}
+
+	template, err := client.GetTemplate(medium, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting template: %v", err)
+	}
+
+	content, _ := template[iterable.TemplateContentField(medium)].(string)
+	return []byte(content), nil
+}
+
+func init() {
+	ViewCmd.Flags().String("email", "", "Email address of the user the message was sent to")
+	ViewCmd.Flags().String("medium", "email", "Message medium: email, sms or push")
+	ViewCmd.Flags().StringP("out", "o", "", "File to write the message to instead of stdout")
+	ViewCmd.Flags().Bool("open", false, "Open the message in the default browser, using a temporary file unless --out is set")
+}
@@ -0,0 +1,18 @@
+package send
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the send command
+var Cmd = &cobra.Command{
+	Use:   "send",
+	Short: "Send a campaign to a single user",
+}
+
+func init() {
+	Cmd.AddCommand(EmailCmd)
+	Cmd.AddCommand(SMSCmd)
+	Cmd.AddCommand(PushCmd)
+	Cmd.AddCommand(InAppCmd)
+}
@@ -0,0 +1,91 @@
+package send
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// EmailCmd represents the email command for send
+var EmailCmd = newTargetCmd("email", "an email")
+
+// SMSCmd represents the sms command for send
+var SMSCmd = newTargetCmd("sms", "an SMS")
+
+// PushCmd represents the push command for send
+var PushCmd = newTargetCmd("push", "a push notification")
+
+// InAppCmd represents the inapp command for send
+var InAppCmd = newTargetCmd("inapp", "an in-app message")
+
+// newTargetCmd creates a command that sends a campaign of the given medium
+// to a single user
+func newTargetCmd(medium, description string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   medium,
+		Short: fmt.Sprintf("Send %s campaign to a single user", description),
+		Example: fmt.Sprintf(`iterablectl send %[1]s --campaign-id 1234 --recipient-email user@example.com
+iterablectl send %[1]s --campaign-id 1234 --recipient-user-id 42 --data-fields-file order.json --send-at "2025-03-01 09:00:00"`, medium),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, _ := cmd.Flags().GetString("api-key")
+			client := iterable.NewClient(apiKey)
+
+			target := iterable.TargetRequest{}
+			target.CampaignID, _ = cmd.Flags().GetInt("campaign-id")
+			target.RecipientEmail, _ = cmd.Flags().GetString("recipient-email")
+			target.RecipientUserID, _ = cmd.Flags().GetString("recipient-user-id")
+			target.AllowRepeatMarketingSends, _ = cmd.Flags().GetBool("allow-repeat-marketing-sends")
+			sendAt, _ := cmd.Flags().GetString("send-at")
+
+			if target.CampaignID == 0 {
+				return fmt.Errorf("--campaign-id is required")
+			}
+			if (target.RecipientEmail == "") == (target.RecipientUserID == "") {
+				return fmt.Errorf("exactly one of --recipient-email or --recipient-user-id must be specified")
+			}
+
+			dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+			dataFile, _ := cmd.Flags().GetString("data-fields-file")
+			dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+			if err != nil {
+				return err
+			}
+			target.DataFields = dataFields
+
+			if sendAt != "" {
+				t, err := utils.ParseTime(sendAt)
+				if err != nil {
+					return err
+				}
+				target.SendAt = t
+			}
+
+			if err := client.SendTarget(medium, target); err != nil {
+				return fmt.Errorf("error sending %s: %v", medium, err)
+			}
+
+			recipient := target.RecipientEmail
+			if recipient == "" {
+				recipient = "userID " + target.RecipientUserID
+			}
+			if target.SendAt.IsZero() {
+				fmt.Printf("Campaign %d sent to %s\n", target.CampaignID, recipient)
+			} else {
+				fmt.Printf("Campaign %d scheduled for %s at %s\n", target.CampaignID, recipient, target.SendAt.Local().Format("2006-01-02 15:04:05 MST"))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int("campaign-id", 0, "Campaign ID to send")
+	cmd.Flags().String("recipient-email", "", "Email address of the recipient")
+	cmd.Flags().String("recipient-user-id", "", "User ID of the recipient")
+	cmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	cmd.Flags().String("data-fields-file", "", "JSON file containing data fields")
+	cmd.Flags().String("send-at", "", "Time to send (RFC 3339, YYYY-MM-DD HH:MM:SS or unix seconds), sends immediately if not set")
+	cmd.Flags().Bool("allow-repeat-marketing-sends", false, "Allow sending a marketing campaign the user has already received")
+
+	return cmd
+}
@@ -0,0 +1,20 @@
+package snippets
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the snippets command
+var Cmd = &cobra.Command{
+	Use:   "snippets",
+	Short: "Manage Iterable snippets",
+}
+
+func init() {
+	Cmd.AddCommand(ListCmd)
+	Cmd.AddCommand(GetCmd)
+	Cmd.AddCommand(CreateCmd)
+	Cmd.AddCommand(UpdateCmd)
+	Cmd.AddCommand(DeleteCmd)
+	Cmd.AddCommand(SyncCmd)
+}
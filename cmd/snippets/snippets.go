@@ -0,0 +1,183 @@
+package snippets
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd represents the list command for snippets
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List snippets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		snippets, err := client.GetSnippets()
+		if err != nil {
+			return fmt.Errorf("error getting snippets: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(snippets)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, snippet := range *snippets {
+			updatedAt := utils.FormatMillis(snippet.UpdatedAt)
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", snippet.ID, snippet.Name, updatedAt, snippet.UpdatedBy, snippet.Description)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// GetCmd represents the get command for snippets
+var GetCmd = &cobra.Command{
+	Use:   "get <idOrName>",
+	Short: "Get a snippet",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl snippets get footer
+iterablectl snippets get footer --format json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		snippet, err := client.GetSnippet(args[0])
+		if err != nil {
+			return fmt.Errorf("error getting snippet: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(snippet)
+		}
+
+		fmt.Println(snippet.Content)
+		return nil
+	},
+}
+
+// CreateCmd represents the create command for snippets
+var CreateCmd = &cobra.Command{
+	Use:     "create <name>",
+	Short:   "Create a snippet",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl snippets create footer --file footer.html --description \"Shared footer\"",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		snippet, err := snippetFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		snippet.Name = args[0]
+
+		id, err := client.CreateSnippet(snippet)
+		if err != nil {
+			return fmt.Errorf("error creating snippet: %v", err)
+		}
+
+		fmt.Printf("Snippet %d '%s' successfully created\n", id, snippet.Name)
+		return nil
+	},
+}
+
+// UpdateCmd represents the update command for snippets
+var UpdateCmd = &cobra.Command{
+	Use:     "update <idOrName>",
+	Short:   "Update a snippet",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl snippets update footer --file footer.html",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		snippet, err := snippetFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		// Keep the current description and variables unless new ones are
+		// given, since the update replaces them
+		keepDescription := !cmd.Flags().Changed("description")
+		keepVariables := !cmd.Flags().Changed("variable")
+		if keepDescription || keepVariables {
+			current, err := client.GetSnippet(args[0])
+			if err != nil {
+				return fmt.Errorf("error getting snippet: %v", err)
+			}
+			if keepDescription {
+				snippet.Description = current.Description
+			}
+			if keepVariables {
+				snippet.Variables = current.Variables
+			}
+		}
+
+		if err := client.UpdateSnippet(args[0], snippet); err != nil {
+			return fmt.Errorf("error updating snippet: %v", err)
+		}
+
+		fmt.Printf("Snippet '%s' successfully updated\n", args[0])
+		return nil
+	},
+}
+
+// DeleteCmd represents the delete command for snippets
+var DeleteCmd = &cobra.Command{
+	Use:     "delete <idOrName>",
+	Short:   "Delete a snippet",
+	Args:    cobra.ExactArgs(1),
+	Example: "iterablectl snippets delete footer",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		if err := client.DeleteSnippet(args[0]); err != nil {
+			return fmt.Errorf("error deleting snippet: %v", err)
+		}
+
+		fmt.Printf("Snippet '%s' successfully deleted\n", args[0])
+		return nil
+	},
+}
+
+// snippetFromFlags reads snippet content from --file and the description
+// and variables from flags
+func snippetFromFlags(cmd *cobra.Command) (iterable.Snippet, error) {
+	snippet := iterable.Snippet{}
+	snippet.Description, _ = cmd.Flags().GetString("description")
+	snippet.Variables, _ = cmd.Flags().GetStringSlice("variable")
+	file, _ := cmd.Flags().GetString("file")
+
+	if file == "" {
+		return snippet, fmt.Errorf("--file is required")
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return snippet, fmt.Errorf("failed to read snippet file: %v", err)
+	}
+	snippet.Content = string(content)
+
+	return snippet, nil
+}
+
+func init() {
+	ListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+	GetCmd.Flags().String("format", "content", "Output format: json or content (default)")
+
+	for _, cmd := range []*cobra.Command{CreateCmd, UpdateCmd} {
+		cmd.Flags().String("file", "", "File containing the snippet content")
+		cmd.Flags().String("description", "", "Snippet description")
+		cmd.Flags().StringSlice("variable", []string{}, "Variable used by the snippet (can be used multiple times)")
+	}
+}
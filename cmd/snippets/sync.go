@@ -0,0 +1,307 @@
+package snippets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// Snippets are mirrored as one <name>.html file per snippet. The sync state
+// file records, per snippet, the remote updatedAt and the content hash seen
+// at the last sync, which is how local and remote changes are told apart.
+const (
+	snippetExt    = ".html"
+	syncStateFile = ".snippets-sync.json"
+)
+
+// syncEntry is the state of a snippet at its last sync
+type syncEntry struct {
+	ID        int    `json:"id"`
+	UpdatedAt int64  `json:"updatedAt"`
+	SHA256    string `json:"sha256"`
+}
+
+// SyncCmd represents the sync command for snippets
+var SyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Mirror snippets to and from a local directory",
+	Long: `Mirror snippets to and from a local directory, one <name>.html file per snippet.
+
+Remote changes since the last sync are written to disk and local edits are
+pushed to Iterable. New local files are created as snippets. A snippet that
+changed on both sides since the last sync is reported as a conflict and left
+untouched unless --prefer is given. Deletions are never propagated.`,
+	Example: `iterablectl snippets sync --dir snippets
+iterablectl snippets sync --dir snippets --pull-only
+iterablectl snippets sync --dir snippets --prefer remote --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		dir, _ := cmd.Flags().GetString("dir")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		pullOnly, _ := cmd.Flags().GetBool("pull-only")
+		prefer, _ := cmd.Flags().GetString("prefer")
+
+		if dir == "" {
+			return fmt.Errorf("--dir is required")
+		}
+		if prefer != "" && prefer != "local" && prefer != "remote" {
+			return fmt.Errorf("--prefer must be local or remote")
+		}
+		if pullOnly && prefer == "local" {
+			return fmt.Errorf("--prefer local cannot be used with --pull-only")
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %v", err)
+		}
+
+		state, err := readSyncState(dir)
+		if err != nil {
+			return err
+		}
+		local, err := readLocalSnippets(dir)
+		if err != nil {
+			return err
+		}
+
+		snippets, err := client.GetSnippets()
+		if err != nil {
+			return fmt.Errorf("error getting snippets: %v", err)
+		}
+		// Names come from the server and become file names, so skip any
+		// that would escape dir
+		remote := make(map[string]iterable.Snippet, len(*snippets))
+		invalid := 0
+		for _, snippet := range *snippets {
+			if !isSnippetFileName(snippet.Name) {
+				fmt.Printf("skip  %s (not a valid file name)\n", snippet.Name)
+				invalid++
+				continue
+			}
+			remote[snippet.Name] = snippet
+		}
+
+		names := map[string]bool{}
+		for name := range remote {
+			names[name] = true
+		}
+		for name := range local {
+			names[name] = true
+		}
+		for name := range state {
+			names[name] = true
+		}
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+		sort.Strings(sorted)
+
+		prefix := ""
+		if dryRun {
+			prefix = "[dry-run] "
+		}
+
+		pull := func(snippet iterable.Snippet) error {
+			fmt.Printf("%spull  %s\n", prefix, snippet.Name)
+			if dryRun {
+				return nil
+			}
+			path := filepath.Join(dir, snippet.Name+snippetExt)
+			if err := os.WriteFile(path, []byte(snippet.Content), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %v", path, err)
+			}
+			state[snippet.Name] = syncEntry{ID: snippet.ID, UpdatedAt: snippet.UpdatedAt, SHA256: hashContent(snippet.Content)}
+			return nil
+		}
+
+		push := func(name, content string, current *iterable.Snippet) error {
+			action := "push"
+			if current == nil {
+				action = "create"
+			}
+			fmt.Printf("%s%-6s%s\n", prefix, action, name)
+			if dryRun {
+				return nil
+			}
+
+			if current == nil {
+				if _, err := client.CreateSnippet(iterable.Snippet{Name: name, Content: content}); err != nil {
+					return fmt.Errorf("error creating snippet %s: %v", name, err)
+				}
+			} else {
+				update := iterable.Snippet{Content: content, Description: current.Description, Variables: current.Variables}
+				if err := client.UpdateSnippet(name, update); err != nil {
+					return fmt.Errorf("error updating snippet %s: %v", name, err)
+				}
+			}
+
+			// Record the updatedAt assigned by the push so it is not seen as
+			// a remote change on the next sync
+			updated, err := client.GetSnippet(name)
+			if err != nil {
+				return fmt.Errorf("error getting snippet %s: %v", name, err)
+			}
+			state[name] = syncEntry{ID: updated.ID, UpdatedAt: updated.UpdatedAt, SHA256: hashContent(content)}
+			return nil
+		}
+
+		conflicts := 0
+		var syncErr error
+		for _, name := range sorted {
+			r, inRemote := remote[name]
+			content, inLocal := local[name]
+			last, inState := state[name]
+
+			switch {
+			case inRemote && !inLocal:
+				if inState {
+					fmt.Printf("skip  %s (deleted locally)\n", name)
+					continue
+				}
+				syncErr = pull(r)
+
+			case !inRemote && inLocal:
+				if inState {
+					fmt.Printf("skip  %s (deleted remotely)\n", name)
+					continue
+				}
+				if pullOnly {
+					continue
+				}
+				syncErr = push(name, content, nil)
+
+			case !inRemote && !inLocal:
+				delete(state, name)
+
+			default:
+				localChanged := !inState || hashContent(content) != last.SHA256
+				remoteChanged := !inState || r.UpdatedAt > last.UpdatedAt
+
+				if content == r.Content {
+					if !dryRun {
+						state[name] = syncEntry{ID: r.ID, UpdatedAt: r.UpdatedAt, SHA256: hashContent(content)}
+					}
+					continue
+				}
+
+				switch {
+				case localChanged && remoteChanged:
+					switch prefer {
+					case "remote":
+						syncErr = pull(r)
+					case "local":
+						syncErr = push(name, content, &r)
+					default:
+						fmt.Printf("conflict %s (changed locally and remotely since last sync)\n", name)
+						conflicts++
+					}
+				case remoteChanged:
+					syncErr = pull(r)
+				case localChanged && !pullOnly:
+					syncErr = push(name, content, &r)
+				}
+			}
+
+			if syncErr != nil {
+				break
+			}
+		}
+
+		if !dryRun {
+			if err := writeSyncState(dir, state); err != nil {
+				return err
+			}
+		}
+		if syncErr != nil {
+			return syncErr
+		}
+		if conflicts > 0 {
+			return fmt.Errorf("%d snippet(s) in conflict; resolve them or rerun with --prefer local|remote", conflicts)
+		}
+		if invalid > 0 {
+			return fmt.Errorf("%d snippet(s) skipped because their names cannot be used as file names", invalid)
+		}
+
+		return nil
+	},
+}
+
+// isSnippetFileName reports whether a snippet name can be used as a file
+// name directly inside the sync directory
+func isSnippetFileName(name string) bool {
+	return name != "" && filepath.IsLocal(name) && !strings.ContainsAny(name, `/\`)
+}
+
+// hashContent returns the hex encoded SHA-256 of snippet content
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// readLocalSnippets reads the snippet files in dir, keyed by snippet name
+func readLocalSnippets(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %v", err)
+	}
+
+	snippets := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != snippetExt {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		snippets[strings.TrimSuffix(entry.Name(), snippetExt)] = string(content)
+	}
+
+	return snippets, nil
+}
+
+// readSyncState reads the sync state file in dir, if any
+func readSyncState(dir string) (map[string]syncEntry, error) {
+	state := map[string]syncEntry{}
+
+	data, err := os.ReadFile(filepath.Join(dir, syncStateFile))
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", syncStateFile, err)
+	}
+
+	return state, nil
+}
+
+// writeSyncState writes the sync state file in dir
+func writeSyncState(dir string, state map[string]syncEntry) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, syncStateFile), append(data, '\n'), 0644)
+}
+
+func init() {
+	SyncCmd.Flags().String("dir", "", "Directory to mirror snippets to")
+	SyncCmd.Flags().Bool("dry-run", false, "Show what would change without writing anything")
+	SyncCmd.Flags().Bool("pull-only", false, "Only pull remote changes, never push local ones")
+	SyncCmd.Flags().String("prefer", "", "Resolve conflicts in favour of local or remote")
+}
@@ -0,0 +1,72 @@
+package subscriptions
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ChannelsCmd represents the channels command for subscriptions
+var ChannelsCmd = &cobra.Command{
+	Use:   "channels",
+	Short: "List message channels",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		channels, err := client.GetChannels()
+		if err != nil {
+			return fmt.Errorf("error getting channels: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(channels)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, channel := range *channels {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", channel.ID, channel.Name, channel.ChannelType, channel.MessageMedium)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// MessageTypesCmd represents the message-types command for subscriptions
+var MessageTypesCmd = &cobra.Command{
+	Use:   "message-types",
+	Short: "List message types",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		messageTypes, err := client.GetMessageTypes()
+		if err != nil {
+			return fmt.Errorf("error getting message types: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(messageTypes)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, messageType := range *messageTypes {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", messageType.ID, messageType.Name, messageType.ChannelID, messageType.SubscriptionPolicy)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	ChannelsCmd.Flags().String("format", "table", "Output format: json or table (default)")
+	MessageTypesCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
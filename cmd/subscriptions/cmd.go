@@ -0,0 +1,20 @@
+package subscriptions
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the subscriptions command
+var Cmd = &cobra.Command{
+	Use:   "subscriptions",
+	Short: "Manage user subscription preferences",
+}
+
+func init() {
+	Cmd.AddCommand(ChannelsCmd)
+	Cmd.AddCommand(MessageTypesCmd)
+	Cmd.AddCommand(ShowCmd)
+	Cmd.AddCommand(SubscribeCmd)
+	Cmd.AddCommand(UnsubscribeCmd)
+	Cmd.AddCommand(SetCmd)
+}
@@ -0,0 +1,71 @@
+package subscriptions
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// SetCmd represents the set command for subscriptions
+var SetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Replace a user's subscription lists",
+	Long: `Replace a user's subscription lists.
+
+Each list given replaces the user's current list entirely; lists that are not
+given are left unchanged. Pass a flag with an empty value to clear a list.`,
+	Example: `iterablectl subscriptions set --email user@example.com --unsubscribed-channel-ids 5,6
+iterablectl subscriptions set --user-id 42 --email-list-ids ""`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		update := iterable.SubscriptionUpdate{}
+		update.Email, _ = cmd.Flags().GetString("email")
+		update.UserID, _ = cmd.Flags().GetString("user-id")
+
+		if (update.Email == "") == (update.UserID == "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+
+		lists := map[string]*[]int{
+			"email-list-ids":                &update.EmailListIDs,
+			"unsubscribed-channel-ids":      &update.UnsubscribedChannelIDs,
+			"unsubscribed-message-type-ids": &update.UnsubscribedMessageTypeIDs,
+			"subscribed-message-type-ids":   &update.SubscribedMessageTypeIDs,
+		}
+		changed := false
+		for flag, list := range lists {
+			if cmd.Flags().Changed(flag) {
+				values, _ := cmd.Flags().GetStringSlice(flag)
+				ids, err := utils.ParseIDs(values)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %v", flag, err)
+				}
+				*list = ids
+				changed = true
+			}
+		}
+		if !changed {
+			return fmt.Errorf("at least one subscription list must be given")
+		}
+
+		if err := client.UpdateSubscriptions(update); err != nil {
+			return fmt.Errorf("error updating subscriptions: %v", err)
+		}
+
+		fmt.Println("Subscriptions updated successfully")
+		return nil
+	},
+}
+
+func init() {
+	SetCmd.Flags().String("email", "", "User email address")
+	SetCmd.Flags().String("user-id", "", "User ID")
+	SetCmd.Flags().StringSlice("email-list-ids", nil, "Email list IDs the user is subscribed to")
+	SetCmd.Flags().StringSlice("unsubscribed-channel-ids", nil, "Channel IDs the user is unsubscribed from")
+	SetCmd.Flags().StringSlice("unsubscribed-message-type-ids", nil, "Message type IDs the user is unsubscribed from")
+	SetCmd.Flags().StringSlice("subscribed-message-type-ids", nil, "Message type IDs the user is subscribed to (for opt-in message types)")
+}
@@ -0,0 +1,109 @@
+package subscriptions
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ShowCmd represents the show command for subscriptions
+var ShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show a user's subscriptions",
+	Example: `iterablectl subscriptions show --email user@example.com
+iterablectl subscriptions show --user-id 42 --format json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		email, _ := cmd.Flags().GetString("email")
+		userId, _ := cmd.Flags().GetString("user-id")
+
+		if (email == "") == (userId == "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+
+		var user *iterable.User
+		var err error
+		if userId != "" {
+			user, err = client.GetUserByID(userId)
+		} else {
+			user, err = client.GetUser(email)
+		}
+		if err != nil {
+			return fmt.Errorf("error getting user: %v", err)
+		}
+
+		subscriptions := map[string][]int{
+			"emailListIds":               toIDs(user.DataFields["emailListIds"]),
+			"unsubscribedChannelIds":     toIDs(user.DataFields["unsubscribedChannelIds"]),
+			"unsubscribedMessageTypeIds": toIDs(user.DataFields["unsubscribedMessageTypeIds"]),
+			"subscribedMessageTypeIds":   toIDs(user.DataFields["subscribedMessageTypeIds"]),
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(subscriptions)
+		}
+
+		// Resolve names, tolerating failures since names are informational
+		channelNames := make(map[int]string)
+		if channels, err := client.GetChannels(); err == nil {
+			for _, channel := range *channels {
+				channelNames[channel.ID] = channel.Name
+			}
+		}
+		messageTypeNames := make(map[int]string)
+		if messageTypes, err := client.GetMessageTypes(); err == nil {
+			for _, messageType := range *messageTypes {
+				messageTypeNames[messageType.ID] = messageType.Name
+			}
+		}
+		listNames := make(map[int]string)
+		if lists, err := client.GetLists(); err == nil {
+			for _, list := range *lists {
+				listNames[list.ID] = list.Name
+			}
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TYPE\tID\tNAME\tSTATUS")
+		for _, id := range subscriptions["emailListIds"] {
+			fmt.Fprintf(w, "email list\t%d\t%s\tsubscribed\n", id, listNames[id])
+		}
+		for _, id := range subscriptions["unsubscribedChannelIds"] {
+			fmt.Fprintf(w, "channel\t%d\t%s\tunsubscribed\n", id, channelNames[id])
+		}
+		for _, id := range subscriptions["subscribedMessageTypeIds"] {
+			fmt.Fprintf(w, "message type\t%d\t%s\tsubscribed\n", id, messageTypeNames[id])
+		}
+		for _, id := range subscriptions["unsubscribedMessageTypeIds"] {
+			fmt.Fprintf(w, "message type\t%d\t%s\tunsubscribed\n", id, messageTypeNames[id])
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// toIDs converts a list of IDs from a user profile to ints
+func toIDs(v any) []int {
+	values, _ := v.([]any)
+	ids := make([]int, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(float64); ok {
+			ids = append(ids, int(id))
+		}
+	}
+	return ids
+}
+
+func init() {
+	ShowCmd.Flags().String("email", "", "User email address")
+	ShowCmd.Flags().String("user-id", "", "User ID")
+	ShowCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
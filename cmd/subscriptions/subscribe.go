@@ -0,0 +1,154 @@
+package subscriptions
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// SubscribeCmd represents the subscribe command for subscriptions
+var SubscribeCmd = &cobra.Command{
+	Use:   "subscribe",
+	Short: "Subscribe users to a message type, channel or email list",
+	Example: `iterablectl subscriptions subscribe --email user@example.com --message-type 12
+iterablectl subscriptions subscribe --file emails.txt --list 34`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSubscription(cmd, "subscribe")
+	},
+}
+
+// UnsubscribeCmd represents the unsubscribe command for subscriptions
+var UnsubscribeCmd = &cobra.Command{
+	Use:   "unsubscribe",
+	Short: "Unsubscribe users from a message type, channel or email list",
+	Example: `iterablectl subscriptions unsubscribe --user-id 42 --channel 5
+iterablectl subscriptions unsubscribe --file ids.txt --by-userid --message-type 12`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSubscription(cmd, "unsubscribe")
+	},
+}
+
+// runSubscription subscribes or unsubscribes a single user or all users in
+// a file from the selected subscription group
+func runSubscription(cmd *cobra.Command, action string) error {
+	apiKey, _ := cmd.Flags().GetString("api-key")
+	client := iterable.NewClient(apiKey)
+
+	email, _ := cmd.Flags().GetString("email")
+	userId, _ := cmd.Flags().GetString("user-id")
+	file, _ := cmd.Flags().GetString("file")
+	byUserID, _ := cmd.Flags().GetBool("by-userid")
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+
+	group, groupID, err := subscriptionGroup(cmd)
+	if err != nil {
+		return err
+	}
+
+	given := 0
+	for _, v := range []string{email, userId, file} {
+		if v != "" {
+			given++
+		}
+	}
+	if given != 1 {
+		return fmt.Errorf("exactly one of --email, --user-id or --file must be specified")
+	}
+
+	if file == "" {
+		if action == "subscribe" {
+			err = client.Subscribe(group, groupID, email, userId)
+		} else {
+			err = client.Unsubscribe(group, groupID, email, userId)
+		}
+		if err != nil {
+			return fmt.Errorf("error trying to %s user: %v", action, err)
+		}
+
+		fmt.Printf("User %sd successfully\n", action)
+		return nil
+	}
+
+	if batchSize <= 0 {
+		return fmt.Errorf("--batch-size must be greater than zero")
+	}
+	identifiers, err := utils.ReadLines(file)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %v", err)
+	}
+	if len(identifiers) == 0 {
+		return fmt.Errorf("no users found in %s", file)
+	}
+
+	var succeeded, failed int
+	for start := 0; start < len(identifiers); start += batchSize {
+		end := min(start+batchSize, len(identifiers))
+
+		var emails, userIDs []string
+		if byUserID {
+			userIDs = identifiers[start:end]
+		} else {
+			emails = identifiers[start:end]
+		}
+
+		response, err := client.BulkUpdateSubscription(group, groupID, action, emails, userIDs)
+		if err != nil {
+			return fmt.Errorf("error trying to %s users %d-%d: %v", action, start+1, end, err)
+		}
+
+		succeeded += response.SuccessCount
+		failed += response.FailCount
+		for _, email := range response.InvalidEmails {
+			fmt.Fprintf(os.Stderr, "Invalid email: %s\n", email)
+		}
+		for _, userID := range response.InvalidUserIds {
+			fmt.Fprintf(os.Stderr, "Invalid userID: %s\n", userID)
+		}
+	}
+
+	fmt.Printf("%sd %d users (%d failed)\n", utils.Capitalize(action), succeeded, failed)
+	return nil
+}
+
+// subscriptionGroup returns the subscription group and ID selected with
+// --message-type, --channel or --list
+func subscriptionGroup(cmd *cobra.Command) (string, int, error) {
+	flags := map[string]string{
+		"message-type": "messageType",
+		"channel":      "messageChannel",
+		"list":         "emailList",
+	}
+
+	group, groupID := "", 0
+	for flag, g := range flags {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+		if group != "" {
+			return "", 0, fmt.Errorf("exactly one of --message-type, --channel or --list must be specified")
+		}
+		group = g
+		groupID, _ = cmd.Flags().GetInt(flag)
+	}
+	if group == "" {
+		return "", 0, fmt.Errorf("exactly one of --message-type, --channel or --list must be specified")
+	}
+
+	return group, groupID, nil
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{SubscribeCmd, UnsubscribeCmd} {
+		cmd.Flags().String("email", "", "User email address")
+		cmd.Flags().String("user-id", "", "User ID")
+		cmd.Flags().String("file", "", "File containing one email (or user ID with --by-userid) per line")
+		cmd.Flags().Bool("by-userid", false, "Treat file entries as user IDs instead of emails")
+		cmd.Flags().Int("batch-size", 1000, "Number of users to update per request when using --file")
+		cmd.Flags().Int("message-type", 0, "Message type ID")
+		cmd.Flags().Int("channel", 0, "Message channel ID")
+		cmd.Flags().Int("list", 0, "Email list ID")
+	}
+}
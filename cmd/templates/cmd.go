@@ -0,0 +1,20 @@
+package templates
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the templates command
+var Cmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manage Iterable templates",
+}
+
+func init() {
+	Cmd.AddCommand(ListCmd)
+	Cmd.AddCommand(GetCmd)
+	Cmd.AddCommand(ExportCmd)
+	Cmd.AddCommand(ImportCmd)
+	Cmd.AddCommand(ProofCmd)
+	Cmd.AddCommand(RenderCmd)
+}
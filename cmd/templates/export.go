@@ -0,0 +1,60 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// ExportCmd represents the export command for templates
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export templates to HTML and metadata files",
+	Example: `iterablectl templates export --dir templates
+iterablectl templates export --dir templates --medium email --template-type Base`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		dir, _ := cmd.Flags().GetString("dir")
+		templateType, _ := cmd.Flags().GetString("template-type")
+		mediums, _ := cmd.Flags().GetStringSlice("medium")
+
+		if dir == "" {
+			return fmt.Errorf("--dir is required")
+		}
+		if len(mediums) == 0 {
+			mediums = iterable.TemplateMediums
+		}
+
+		count := 0
+		for _, medium := range mediums {
+			templates, err := client.GetTemplates(templateType, medium)
+			if err != nil {
+				return fmt.Errorf("error getting %s templates: %v", medium, err)
+			}
+
+			for _, summary := range *templates {
+				template, err := client.GetTemplate(medium, summary.TemplateID)
+				if err != nil {
+					return fmt.Errorf("error getting template %d: %v", summary.TemplateID, err)
+				}
+
+				if err := writeTemplate(dir, medium, summary.TemplateID, template); err != nil {
+					return fmt.Errorf("failed to write template %d: %v", summary.TemplateID, err)
+				}
+				count++
+			}
+		}
+
+		fmt.Printf("Exported %d templates to %s\n", count, dir)
+		return nil
+	},
+}
+
+func init() {
+	ExportCmd.Flags().String("dir", "", "Directory to write templates to")
+	ExportCmd.Flags().String("template-type", "", "Only export templates used by this kind of campaign: Base, Blast, Triggered or Workflow")
+	ExportCmd.Flags().StringSlice("medium", []string{}, "Only export templates of these mediums: email, sms, push or inapp (default all)")
+}
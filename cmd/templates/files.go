@@ -0,0 +1,80 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+)
+
+// Templates are exported as one directory per medium, holding a metadata
+// file and a content file per template, e.g. email/1234.json and
+// email/1234.html.
+
+// readOnlyTemplateFields are not exported since they change on every update
+// and cannot be set through the API
+var readOnlyTemplateFields = []string{"createdAt", "updatedAt", "creatorUserId"}
+
+// contentExt returns the file extension for the content of a template medium
+func contentExt(medium string) string {
+	if iterable.TemplateContentField(medium) == "html" {
+		return ".html"
+	}
+	return ".txt"
+}
+
+// writeTemplate writes a template's metadata and content to dir
+func writeTemplate(dir, medium string, id int, template iterable.Template) error {
+	contentField := iterable.TemplateContentField(medium)
+	content, _ := template[contentField].(string)
+
+	metadata := make(iterable.Template, len(template))
+	for k, v := range template {
+		metadata[k] = v
+	}
+	delete(metadata, contentField)
+	for _, field := range readOnlyTemplateFields {
+		delete(metadata, field)
+	}
+
+	metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	base := filepath.Join(dir, medium, fmt.Sprintf("%d", id))
+	if err := os.MkdirAll(filepath.Dir(base), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(base+".json", append(metadataJSON, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(base+contentExt(medium), []byte(content), 0644)
+}
+
+// readTemplate reads a template from its metadata file and the content file
+// next to it
+func readTemplate(metadataPath, medium string) (iterable.Template, error) {
+	data, err := os.ReadFile(metadataPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var template iterable.Template
+	if err := json.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", metadataPath, err)
+	}
+
+	contentPath := metadataPath[:len(metadataPath)-len(filepath.Ext(metadataPath))] + contentExt(medium)
+	content, err := os.ReadFile(contentPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		template[iterable.TemplateContentField(medium)] = string(content)
+	}
+
+	return template, nil
+}
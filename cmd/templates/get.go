@@ -0,0 +1,54 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// GetCmd represents the get command for templates
+var GetCmd = &cobra.Command{
+	Use:   "get <templateId>",
+	Short: "Get a template",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl templates get 1234
+iterablectl templates get 1234 --out template.html
+iterablectl templates get 1234 --medium sms`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid templateId: %s", args[0])
+		}
+
+		medium, _ := cmd.Flags().GetString("medium")
+		out, _ := cmd.Flags().GetString("out")
+
+		template, err := client.GetTemplate(medium, id)
+		if err != nil {
+			return fmt.Errorf("error getting template: %v", err)
+		}
+
+		if out != "" {
+			content, _ := template[iterable.TemplateContentField(medium)].(string)
+			if err := os.WriteFile(out, []byte(content), 0644); err != nil {
+				return fmt.Errorf("failed to write template: %v", err)
+			}
+			fmt.Printf("Template %d written to %s\n", id, out)
+			return nil
+		}
+
+		return utils.PrintJSON(template)
+	},
+}
+
+func init() {
+	GetCmd.Flags().String("medium", "email", "Message medium: email, sms, push or inapp")
+	GetCmd.Flags().StringP("out", "o", "", "Write the template content (HTML for email and in-app) to this file instead of printing the template")
+}
@@ -0,0 +1,74 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/spf13/cobra"
+)
+
+// ImportCmd represents the import command for templates
+var ImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import templates from files written by export",
+	Long: `Import templates from files written by export.
+
+Templates with a clientTemplateId are upserted by it, other templates are
+updated by their templateId.`,
+	Example: `iterablectl templates import --dir templates
+iterablectl templates import --dir templates --medium email --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		dir, _ := cmd.Flags().GetString("dir")
+		mediums, _ := cmd.Flags().GetStringSlice("medium")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if dir == "" {
+			return fmt.Errorf("--dir is required")
+		}
+		if len(mediums) == 0 {
+			mediums = iterable.TemplateMediums
+		}
+
+		count := 0
+		for _, medium := range mediums {
+			paths, err := filepath.Glob(filepath.Join(dir, medium, "*.json"))
+			if err != nil {
+				return err
+			}
+
+			for _, path := range paths {
+				template, err := readTemplate(path, medium)
+				if err != nil {
+					return fmt.Errorf("failed to read template: %v", err)
+				}
+
+				if dryRun {
+					fmt.Printf("Would import %s template %s\n", medium, path)
+				} else if err := client.UpsertTemplate(medium, template); err != nil {
+					return fmt.Errorf("error importing %s: %v", path, err)
+				}
+				count++
+			}
+		}
+
+		if count == 0 {
+			fmt.Fprintf(os.Stderr, "No templates found in %s\n", dir)
+			return nil
+		}
+		if !dryRun {
+			fmt.Printf("Imported %d templates from %s\n", count, dir)
+		}
+		return nil
+	},
+}
+
+func init() {
+	ImportCmd.Flags().String("dir", "", "Directory to read templates from")
+	ImportCmd.Flags().StringSlice("medium", []string{}, "Only import templates of these mediums: email, sms, push or inapp (default all)")
+	ImportCmd.Flags().Bool("dry-run", false, "List the templates that would be imported without importing them")
+}
@@ -0,0 +1,51 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd represents the list command for templates
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List templates",
+	Example: `iterablectl templates list
+iterablectl templates list --template-type Blast --medium email`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		templateType, _ := cmd.Flags().GetString("template-type")
+		medium, _ := cmd.Flags().GetString("medium")
+
+		templates, err := client.GetTemplates(templateType, medium)
+		if err != nil {
+			return fmt.Errorf("error getting templates: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(templates)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, template := range *templates {
+			updatedAt := utils.FormatMillis(int64(template.UpdatedAt))
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", template.TemplateID, template.Name, template.ClientTemplateID, updatedAt)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	ListCmd.Flags().String("template-type", "", "Only list templates used by this kind of campaign: Base, Blast, Triggered or Workflow")
+	ListCmd.Flags().String("medium", "", "Message medium: email, sms, push or inapp")
+	ListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
@@ -0,0 +1,64 @@
+package templates
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ProofCmd represents the proof command for templates
+var ProofCmd = &cobra.Command{
+	Use:   "proof <templateId>",
+	Short: "Send a proof of a template to a user",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl templates proof 1234 --recipient-email designer@example.com
+iterablectl templates proof 1234 --medium sms --recipient-user-id 42 --data-field promo=SPRING`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid templateId: %s", args[0])
+		}
+
+		medium, _ := cmd.Flags().GetString("medium")
+		email, _ := cmd.Flags().GetString("recipient-email")
+		userId, _ := cmd.Flags().GetString("recipient-user-id")
+
+		if (email == "" && userId == "") || (email != "" && userId != "") {
+			return fmt.Errorf("exactly one of --recipient-email or --recipient-user-id must be specified")
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+
+		err = client.SendTemplateProof(medium, iterable.ProofRequest{
+			TemplateID:      id,
+			RecipientEmail:  email,
+			RecipientUserID: userId,
+			DataFields:      dataFields,
+		})
+		if err != nil {
+			return fmt.Errorf("error sending proof: %v", err)
+		}
+
+		fmt.Printf("Proof of template %d sent successfully\n", id)
+		return nil
+	},
+}
+
+func init() {
+	ProofCmd.Flags().String("medium", "email", "Message medium: email, sms, push or inapp")
+	ProofCmd.Flags().String("recipient-email", "", "Email address of the proof recipient")
+	ProofCmd.Flags().String("recipient-user-id", "", "User ID of the proof recipient")
+	ProofCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
+	ProofCmd.Flags().String("data-file", "", "JSON file containing data fields")
+}
@@ -0,0 +1,103 @@
+package templates
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// RenderCmd represents the render command for templates
+var RenderCmd = &cobra.Command{
+	Use:   "render <templateId>",
+	Short: "Render a template for a user locally",
+	Long: `Render a template for a user locally.
+
+Merge fields such as {{firstName}} are filled in from the user's profile.
+Block helpers such as {{#if}} are not evaluated, and merge fields missing
+from the profile are left in place and reported.`,
+	Args: cobra.ExactArgs(1),
+	Example: `iterablectl templates render 1234 --user-email user@example.com --out preview.html
+iterablectl templates render 1234 --user-email user@example.com --data-field promo=SPRING`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid templateId: %s", args[0])
+		}
+
+		medium, _ := cmd.Flags().GetString("medium")
+		email, _ := cmd.Flags().GetString("user-email")
+		out, _ := cmd.Flags().GetString("out")
+
+		if email == "" {
+			return fmt.Errorf("--user-email is required")
+		}
+
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		extraFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+
+		template, err := client.GetTemplate(medium, id)
+		if err != nil {
+			return fmt.Errorf("error getting template: %v", err)
+		}
+
+		user, err := client.GetUser(email)
+		if err != nil {
+			return fmt.Errorf("error getting user: %v", err)
+		}
+
+		// Profile fields are merged at the top level, with extra data
+		// fields taking precedence like API-triggered data fields do
+		data := make(map[string]any)
+		maps.Copy(data, user.DataFields)
+		data["email"] = user.Email
+		if user.UserID != "" {
+			data["userId"] = user.UserID
+		}
+		maps.Copy(data, extraFields)
+
+		content, _ := template[iterable.TemplateContentField(medium)].(string)
+		rendered, unresolved := utils.RenderMergeFields(content, data)
+
+		if subject, ok := template["subject"].(string); ok {
+			renderedSubject, missing := utils.RenderMergeFields(subject, data)
+			unresolved = append(unresolved, missing...)
+			fmt.Fprintf(os.Stderr, "Subject: %s\n", renderedSubject)
+		}
+		if len(unresolved) > 0 {
+			fmt.Fprintf(os.Stderr, "Unresolved merge fields: %s\n", strings.Join(unresolved, ", "))
+		}
+
+		if out == "" {
+			fmt.Println(rendered)
+			return nil
+		}
+
+		if err := os.WriteFile(out, []byte(rendered), 0644); err != nil {
+			return fmt.Errorf("failed to write rendered template: %v", err)
+		}
+		fmt.Printf("Template %d rendered for %s to %s\n", id, email, out)
+
+		return nil
+	},
+}
+
+func init() {
+	RenderCmd.Flags().String("medium", "email", "Message medium: email, sms, push or inapp")
+	RenderCmd.Flags().String("user-email", "", "Email address of the user whose profile fills in merge fields")
+	RenderCmd.Flags().StringArray("data-field", []string{}, "Additional data field in key=value format (can be used multiple times)")
+	RenderCmd.Flags().String("data-file", "", "JSON file containing additional data fields")
+	RenderCmd.Flags().StringP("out", "o", "", "File to write the rendered template to instead of stdout")
+}
@@ -0,0 +1,101 @@
+package users
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ClearFieldCmd represents the clear-field command for users
+var ClearFieldCmd = &cobra.Command{
+	Use:   "clear-field",
+	Short: "Clear data fields for many users at once",
+	Example: `iterablectl users clear-field --field favoriteColor --file emails.txt
+iterablectl users clear-field --field profile.nickname --field score --file ids.txt --by-userid`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		fields, _ := cmd.Flags().GetStringArray("field")
+		file, _ := cmd.Flags().GetString("file")
+		byUserID, _ := cmd.Flags().GetBool("by-userid")
+		batchSize, _ := cmd.Flags().GetInt("batch-size")
+
+		if len(fields) == 0 {
+			return fmt.Errorf("at least one --field must be provided")
+		}
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if batchSize <= 0 {
+			return fmt.Errorf("--batch-size must be greater than zero")
+		}
+
+		identifiers, err := utils.ReadLines(file)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %v", err)
+		}
+		if len(identifiers) == 0 {
+			return fmt.Errorf("no users found in %s", file)
+		}
+
+		dataFields := make(map[string]any)
+		mergeNestedObjects := false
+		for _, field := range fields {
+			if field == "" {
+				return fmt.Errorf("invalid field: field path cannot be empty")
+			}
+			if strings.Contains(field, ".") {
+				mergeNestedObjects = true
+			}
+			utils.SetPath(dataFields, field, nil)
+		}
+
+		var succeeded, failed int
+		for start := 0; start < len(identifiers); start += batchSize {
+			end := min(start+batchSize, len(identifiers))
+
+			batch := make([]iterable.BulkUpdateUser, 0, end-start)
+			for _, id := range identifiers[start:end] {
+				user := iterable.BulkUpdateUser{
+					DataFields:         dataFields,
+					MergeNestedObjects: mergeNestedObjects,
+				}
+				if byUserID {
+					user.UserID = id
+					user.PreferUserId = true
+				} else {
+					user.Email = id
+				}
+				batch = append(batch, user)
+			}
+
+			response, err := client.BulkUpdateUsers(batch)
+			if err != nil {
+				return fmt.Errorf("error clearing fields for users %d-%d: %v", start+1, end, err)
+			}
+
+			succeeded += response.SuccessCount
+			failed += response.FailCount
+			for _, email := range response.InvalidEmails {
+				fmt.Printf("Invalid email: %s\n", email)
+			}
+			for _, userID := range response.InvalidUserIds {
+				fmt.Printf("Invalid userID: %s\n", userID)
+			}
+		}
+
+		fmt.Printf("Cleared fields for %d users (%d failed)\n", succeeded, failed)
+		return nil
+	},
+}
+
+func init() {
+	ClearFieldCmd.Flags().StringArray("field", []string{}, "Data field to clear, nested fields as dot-separated paths (can be used multiple times)")
+	ClearFieldCmd.Flags().String("file", "", "File containing one email (or user ID with --by-userid) per line")
+	ClearFieldCmd.Flags().Bool("by-userid", false, "Treat file entries as user IDs instead of emails")
+	ClearFieldCmd.Flags().Int("batch-size", 500, "Number of users to update per request")
+}
@@ -0,0 +1,50 @@
+package users
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// FieldsCmd represents the fields command for users
+var FieldsCmd = &cobra.Command{
+	Use:   "fields",
+	Short: "List user profile fields and their types",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		fields, err := client.GetUserFields()
+		if err != nil {
+			return fmt.Errorf("error getting user fields: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(fields)
+		}
+
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, name := range names {
+			fmt.Fprintf(w, "%s\t%s\n", name, fields[name])
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+func init() {
+	FieldsCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
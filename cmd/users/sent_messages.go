@@ -0,0 +1,98 @@
+package users
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// SentMessagesCmd represents the sent-messages command for users
+var SentMessagesCmd = &cobra.Command{
+	Use:   "sent-messages",
+	Short: "List the messages sent to a user",
+	Example: `iterablectl users sent-messages --email user@example.com
+iterablectl users sent-messages --user-id 42 --medium Email --start 2025-01-01 --campaign-id 1234`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		opts := iterable.GetSentMessagesOpts{}
+		opts.Email, _ = cmd.Flags().GetString("email")
+		opts.UserID, _ = cmd.Flags().GetString("user-id")
+		opts.Limit, _ = cmd.Flags().GetInt("limit")
+		opts.Medium, _ = cmd.Flags().GetString("medium")
+		opts.CampaignIDs, _ = cmd.Flags().GetIntSlice("campaign-id")
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+
+		if (opts.Email == "") == (opts.UserID == "") {
+			return fmt.Errorf("exactly one of --email or --user-id must be specified")
+		}
+
+		var err error
+		if start != "" {
+			if opts.Start, err = utils.ParseTime(start); err != nil {
+				return err
+			}
+		}
+		if end != "" {
+			if opts.End, err = utils.ParseTime(end); err != nil {
+				return err
+			}
+		}
+
+		messages, err := client.GetSentMessages(opts)
+		if err != nil {
+			return fmt.Errorf("error getting sent messages: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(messages)
+		}
+
+		// Campaign names are informational, so failures to resolve them
+		// are ignored
+		campaignNames := make(map[int]string)
+		if campaigns, err := client.GetCampaigns(); err == nil {
+			for _, campaign := range *campaigns {
+				campaignNames[campaign.ID] = campaign.Name
+			}
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TIME\tCAMPAIGN\tNAME\tMEDIUM\tMESSAGE ID")
+		for _, message := range *messages {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", formatSentAt(message.CreatedAt), message.CampaignID, campaignNames[message.CampaignID], message.MessageMedium, message.MessageID)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// formatSentAt renders a sent message timestamp in local time, falling back
+// to the raw value if it cannot be parsed
+func formatSentAt(s string) string {
+	t, err := time.Parse("2006-01-02 15:04:05 -07:00", s)
+	if err != nil {
+		return s
+	}
+	return t.Local().Format(time.DateTime)
+}
+
+func init() {
+	SentMessagesCmd.Flags().String("email", "", "User email address")
+	SentMessagesCmd.Flags().String("user-id", "", "User ID")
+	SentMessagesCmd.Flags().String("start", "", "Only show messages sent after this time (RFC 3339, YYYY-MM-DD or unix seconds)")
+	SentMessagesCmd.Flags().String("end", "", "Only show messages sent before this time (RFC 3339, YYYY-MM-DD or unix seconds)")
+	SentMessagesCmd.Flags().String("medium", "", "Only show messages of this medium: Email, SMS, Push, InApp or WebPush")
+	SentMessagesCmd.Flags().IntSlice("campaign-id", []int{}, "Only show messages from these campaigns (can be used multiple times)")
+	SentMessagesCmd.Flags().Int("limit", 100, "Maximum number of messages to return")
+	SentMessagesCmd.Flags().String("format", "table", "Output format: json or table (default)")
+}
@@ -1,13 +1,12 @@
 package users
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"maps"
-	"os"
 	"strings"
 
 	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -30,43 +29,49 @@ var UpdateCmd = &cobra.Command{
 			return fmt.Errorf("either --email or --user-id must be provided")
 		}
 
+		// Handle data fields from flags and file
+		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
+		dataFile, _ := cmd.Flags().GetString("data-file")
+		dataFields, err := utils.ParseDataFields(dataFieldsStr, dataFile)
+		if err != nil {
+			return err
+		}
+
 		// Create user object
 		user := iterable.UserUpdateRequest{
 			Email:              email,
 			UserID:             userId,
-			DataFields:         make(map[string]any),
+			DataFields:         dataFields,
 			MergeNestedObjects: mergeNestedObjects,
 			CreateNewFields:    createNewFields,
 			PreferUserId:       preferUserId,
 		}
 
-		// Handle data fields
-		dataFieldsStr, _ := cmd.Flags().GetStringArray("data-field")
-		for _, field := range dataFieldsStr {
-			parts := strings.SplitN(field, "=", 2)
-			if len(parts) != 2 {
-				return fmt.Errorf("invalid data field format: %s, expected format is key=value", field)
+		// Handle fields to unset. Nested paths are sent as nested objects, so
+		// without merging they would replace the whole parent object.
+		unsetFields, _ := cmd.Flags().GetStringArray("unset")
+		for _, field := range unsetFields {
+			if field == "" {
+				return fmt.Errorf("invalid unset field: field path cannot be empty")
 			}
-
-			key, value := parts[0], parts[1]
-			user.DataFields[key] = value
+			if strings.Contains(field, ".") && !mergeNestedObjects {
+				return fmt.Errorf("unsetting nested field %q requires --merge-nested-objects, otherwise its sibling fields are cleared too", field)
+			}
+			utils.SetPath(user.DataFields, field, nil)
 		}
 
-		// Handle data fields from file
-		dataFile, _ := cmd.Flags().GetString("data-file")
-		if dataFile != "" {
-			fileData, err := os.ReadFile(dataFile)
+		// Validate data fields against the project schema, converting
+		// string values to the field types
+		validate, _ := cmd.Flags().GetBool("validate")
+		if validate {
+			schema, err := client.GetUserFields()
 			if err != nil {
-				return fmt.Errorf("failed to read data file: %v", err)
+				return fmt.Errorf("failed to get user fields: %v", err)
 			}
 
-			var dataFields map[string]any
-			if err := json.Unmarshal(fileData, &dataFields); err != nil {
-				return fmt.Errorf("failed to parse data file as JSON: %v", err)
+			if errs := iterable.ValidateDataFields(schema, user.DataFields); len(errs) > 0 {
+				return fmt.Errorf("invalid data fields: %v", errors.Join(errs...))
 			}
-
-			// Merge file data with any command-line data fields
-			maps.Copy(user.DataFields, dataFields)
 		}
 
 		// Update the user
@@ -84,6 +89,8 @@ func init() {
 	UpdateCmd.Flags().String("user-id", "", "User ID")
 	UpdateCmd.Flags().StringArray("data-field", []string{}, "Data field in key=value format (can be used multiple times)")
 	UpdateCmd.Flags().String("data-file", "", "JSON file containing data fields")
+	UpdateCmd.Flags().StringArray("unset", []string{}, "Data field to clear, nested fields as dot-separated paths with --merge-nested-objects (can be used multiple times)")
+	UpdateCmd.Flags().Bool("validate", false, "Reject unknown fields or mismatched types based on the project's user fields, converting values to the field types")
 	UpdateCmd.Flags().Bool("merge-nested-objects", false, "Whether to merge nested objects")
 	UpdateCmd.Flags().Bool("create-new-fields", false, "Whether new fields should be ingested and added to the schema")
 	UpdateCmd.Flags().Bool("prefer-user-id", false, "Whether or not a new user should be created if the request includes a userId that doesn't yet exist in the Iterable project")
@@ -16,4 +16,7 @@ func init() {
 	Cmd.AddCommand(GetCmd)
 	Cmd.AddCommand(MergeCmd)
 	Cmd.AddCommand(DeleteCmd)
+	Cmd.AddCommand(ClearFieldCmd)
+	Cmd.AddCommand(FieldsCmd)
+	Cmd.AddCommand(SentMessagesCmd)
 }
@@ -0,0 +1,19 @@
+package webhooks
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd represents the webhooks command
+var Cmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Manage Iterable system webhooks",
+}
+
+func init() {
+	Cmd.AddCommand(ListCmd)
+	Cmd.AddCommand(UpdateCmd)
+	Cmd.AddCommand(ExportCmd)
+	Cmd.AddCommand(ApplyCmd)
+	Cmd.AddCommand(ListenCmd)
+}
@@ -0,0 +1,242 @@
+package webhooks
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Webhook configuration files list webhooks by endpoint rather than ID, since
+// IDs differ between projects while endpoints usually match.
+
+// webhookConfig is the settings of a webhook as stored in a configuration
+// file. Settings left out of the file are nil and are not changed by apply.
+type webhookConfig struct {
+	Endpoint             string `yaml:"endpoint"`
+	Enabled              *bool  `yaml:"enabled,omitempty"`
+	BlastSendEnabled     *bool  `yaml:"blastSendEnabled,omitempty"`
+	TriggeredSendEnabled *bool  `yaml:"triggeredSendEnabled,omitempty"`
+	ChannelIDs           *[]int `yaml:"channelIds,omitempty,flow"`
+	MessageTypeIDs       *[]int `yaml:"messageTypeIds,omitempty,flow"`
+}
+
+// configFile is the layout of a webhook configuration file
+type configFile struct {
+	Webhooks []webhookConfig `yaml:"webhooks"`
+}
+
+// ExportCmd represents the export command for webhooks
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export system webhook settings to YAML",
+	Example: `iterablectl webhooks export
+iterablectl webhooks export --out webhooks.yaml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		webhooks, err := client.GetWebhooks()
+		if err != nil {
+			return fmt.Errorf("error getting webhooks: %v", err)
+		}
+
+		config := configFile{Webhooks: make([]webhookConfig, 0, len(*webhooks))}
+		for _, webhook := range *webhooks {
+			config.Webhooks = append(config.Webhooks, toConfig(webhook))
+		}
+
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(config); err != nil {
+			return fmt.Errorf("error formatting YAML: %v", err)
+		}
+		data := buf.Bytes()
+
+		out, _ := cmd.Flags().GetString("out")
+		if out == "" {
+			fmt.Print(string(data))
+			return nil
+		}
+		if err := os.WriteFile(out, data, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %v", out, err)
+		}
+
+		fmt.Printf("Exported %d webhooks to %s\n", len(config.Webhooks), out)
+		return nil
+	},
+}
+
+// ApplyCmd represents the apply command for webhooks
+var ApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply system webhook settings from YAML",
+	Long: `Apply system webhook settings from a YAML file written by "webhooks export".
+
+Webhooks are matched by endpoint. Only the settings present in the file are
+compared and changed. The differences with the project are shown before
+anything is changed. Webhooks missing from the project cannot be created
+through the API and are reported; webhooks missing from the file are left
+unchanged.`,
+	Example: `iterablectl webhooks apply --file webhooks.yaml
+iterablectl webhooks apply --file webhooks.yaml --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		file, _ := cmd.Flags().GetString("file")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read webhooks file: %v", err)
+		}
+		var config configFile
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return fmt.Errorf("failed to parse webhooks file: %v", err)
+		}
+
+		webhooks, err := client.GetWebhooks()
+		if err != nil {
+			return fmt.Errorf("error getting webhooks: %v", err)
+		}
+		byEndpoint := make(map[string]iterable.Webhook, len(*webhooks))
+		for _, webhook := range *webhooks {
+			byEndpoint[webhook.Endpoint] = webhook
+		}
+
+		var updates []iterable.WebhookUpdate
+		seen := map[string]bool{}
+		for _, want := range config.Webhooks {
+			if seen[want.Endpoint] {
+				return fmt.Errorf("endpoint %s is listed more than once", want.Endpoint)
+			}
+			seen[want.Endpoint] = true
+
+			current, ok := byEndpoint[want.Endpoint]
+			if !ok {
+				fmt.Printf("! %s\n    not configured in this project, create it in the Iterable UI\n", want.Endpoint)
+				continue
+			}
+
+			update, changes := diffWebhook(current, want)
+			if len(changes) == 0 {
+				continue
+			}
+			fmt.Printf("~ %s (id %d)\n", want.Endpoint, current.ID)
+			for _, change := range changes {
+				fmt.Printf("    %s\n", change)
+			}
+			updates = append(updates, update)
+		}
+
+		if len(updates) == 0 {
+			fmt.Println("No changes to apply")
+			return nil
+		}
+		if dryRun {
+			return nil
+		}
+
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			ok, err := utils.Confirm(fmt.Sprintf("Apply changes to %d webhooks?", len(updates)))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted")
+				return nil
+			}
+		}
+
+		for _, update := range updates {
+			if err := client.UpdateWebhook(update); err != nil {
+				return fmt.Errorf("error updating webhook %d: %v", update.ID, err)
+			}
+		}
+
+		fmt.Printf("%d webhooks successfully updated\n", len(updates))
+		return nil
+	},
+}
+
+// toConfig converts a webhook to its configuration file form
+func toConfig(webhook iterable.Webhook) webhookConfig {
+	channelIDs := sortedIDs(webhook.ChannelIDs)
+	messageTypeIDs := sortedIDs(webhook.MessageTypeIDs)
+	return webhookConfig{
+		Endpoint:             webhook.Endpoint,
+		Enabled:              &webhook.Enabled,
+		BlastSendEnabled:     &webhook.BlastSendEnabled,
+		TriggeredSendEnabled: &webhook.TriggeredSendEnabled,
+		ChannelIDs:           &channelIDs,
+		MessageTypeIDs:       &messageTypeIDs,
+	}
+}
+
+// diffWebhook compares a webhook with the settings wanted in a configuration
+// file, returning an update setting only the fields that differ and a
+// description of each change. Settings missing from the file are ignored.
+func diffWebhook(current iterable.Webhook, want webhookConfig) (iterable.WebhookUpdate, []string) {
+	update := iterable.WebhookUpdate{ID: current.ID}
+	var changes []string
+
+	diffBool := func(name string, from bool, to *bool, field **bool) {
+		if to != nil && from != *to {
+			value := *to
+			*field = &value
+			changes = append(changes, fmt.Sprintf("%s: %t -> %t", name, from, value))
+		}
+	}
+	diffIDs := func(name string, from []int, to *[]int, field *[]int) {
+		if to == nil {
+			return
+		}
+		from, wanted := sortedIDs(from), sortedIDs(*to)
+		if !slices.Equal(from, wanted) {
+			*field = wanted
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, formatIDList(from), formatIDList(wanted)))
+		}
+	}
+
+	diffBool("enabled", current.Enabled, want.Enabled, &update.Enabled)
+	diffBool("blastSendEnabled", current.BlastSendEnabled, want.BlastSendEnabled, &update.BlastSendEnabled)
+	diffBool("triggeredSendEnabled", current.TriggeredSendEnabled, want.TriggeredSendEnabled, &update.TriggeredSendEnabled)
+	diffIDs("channelIds", current.ChannelIDs, want.ChannelIDs, &update.ChannelIDs)
+	diffIDs("messageTypeIds", current.MessageTypeIDs, want.MessageTypeIDs, &update.MessageTypeIDs)
+
+	return update, changes
+}
+
+// sortedIDs returns a sorted copy of ids, never nil
+func sortedIDs(ids []int) []int {
+	sorted := append([]int{}, ids...)
+	slices.Sort(sorted)
+	return sorted
+}
+
+// formatIDList formats IDs the way they appear in the YAML file
+func formatIDList(ids []int) string {
+	if len(ids) == 0 {
+		return "[]"
+	}
+	return "[" + strings.ReplaceAll(formatIDs(ids), ",", ", ") + "]"
+}
+
+func init() {
+	ExportCmd.Flags().String("out", "", "File to write the YAML to (default stdout)")
+
+	ApplyCmd.Flags().String("file", "", "YAML file with the webhook settings")
+	ApplyCmd.Flags().Bool("dry-run", false, "Show the differences without applying them")
+	ApplyCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
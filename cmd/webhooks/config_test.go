@@ -0,0 +1,91 @@
+package webhooks
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDiffWebhook(t *testing.T) {
+	current := iterable.Webhook{
+		ID:               7,
+		Endpoint:         "https://example.com/hook",
+		Enabled:          true,
+		BlastSendEnabled: true,
+		ChannelIDs:       []int{2, 1},
+		MessageTypeIDs:   []int{5},
+	}
+	no, yes := false, true
+
+	tests := []struct {
+		name        string
+		file        string
+		wantUpdate  iterable.WebhookUpdate
+		wantChanges int
+	}{
+		{
+			name:       "missing settings are left unchanged",
+			file:       "endpoint: https://example.com/hook\n",
+			wantUpdate: iterable.WebhookUpdate{ID: 7},
+		},
+		{
+			name:       "matching settings in another order",
+			file:       "endpoint: https://example.com/hook\nenabled: true\nchannelIds: [1, 2]\n",
+			wantUpdate: iterable.WebhookUpdate{ID: 7},
+		},
+		{
+			name:        "changed booleans",
+			file:        "endpoint: https://example.com/hook\nenabled: false\ntriggeredSendEnabled: true\n",
+			wantUpdate:  iterable.WebhookUpdate{ID: 7, Enabled: &no, TriggeredSendEnabled: &yes},
+			wantChanges: 2,
+		},
+		{
+			name:        "changed and cleared lists",
+			file:        "endpoint: https://example.com/hook\nchannelIds: [3, 1]\nmessageTypeIds: []\n",
+			wantUpdate:  iterable.WebhookUpdate{ID: 7, ChannelIDs: []int{1, 3}, MessageTypeIDs: []int{}},
+			wantChanges: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want webhookConfig
+			if err := yaml.Unmarshal([]byte(tt.file), &want); err != nil {
+				t.Fatal(err)
+			}
+
+			update, changes := diffWebhook(current, want)
+			if !reflect.DeepEqual(update, tt.wantUpdate) {
+				t.Errorf("got update %+v, want %+v", update, tt.wantUpdate)
+			}
+			if len(changes) != tt.wantChanges {
+				t.Errorf("got changes %q, want %d", changes, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestExportedConfigRoundTrips(t *testing.T) {
+	current := iterable.Webhook{ID: 7, Endpoint: "https://example.com/hook", Enabled: true, ChannelIDs: []int{2, 1}}
+
+	data, err := yaml.Marshal(configFile{Webhooks: []webhookConfig{toConfig(current)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"blastSendEnabled: false", "channelIds: [1, 2]", "messageTypeIds: []"} {
+		if !strings.Contains(string(data), line) {
+			t.Errorf("export is missing %q:\n%s", line, data)
+		}
+	}
+
+	var config configFile
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		t.Fatal(err)
+	}
+	if _, changes := diffWebhook(current, config.Webhooks[0]); len(changes) != 0 {
+		t.Errorf("exported config differs from the webhook: %q", changes)
+	}
+}
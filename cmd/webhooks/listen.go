@@ -0,0 +1,191 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// maxEventSize is the largest request body accepted from a webhook
+const maxEventSize = 1 << 20
+
+// ListenCmd represents the listen command for webhooks
+var ListenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Run a local server that receives and prints system webhook events",
+	Long: `Run a local HTTP server that receives Iterable system webhook events.
+
+Known event types such as emailSend, emailOpen, emailBounce and smsReceived are
+parsed and printed with their typed fields; other events are printed with
+their raw data fields. Use --out to append every payload to an NDJSON file for
+replay. Expose the port with a tunnel to receive events from Iterable.`,
+	Example: `iterablectl webhooks listen --port 8080
+iterablectl webhooks listen --port 8080 --basic-auth user:pass --out events.ndjson`,
+	Annotations: map[string]string{utils.NoAPIKeyAnnotation: "true"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		port, _ := cmd.Flags().GetInt("port")
+		path, _ := cmd.Flags().GetString("path")
+		basicAuth, _ := cmd.Flags().GetString("basic-auth")
+		out, _ := cmd.Flags().GetString("out")
+
+		var username, password string
+		if basicAuth != "" {
+			var ok bool
+			username, password, ok = strings.Cut(basicAuth, ":")
+			if !ok || username == "" {
+				return fmt.Errorf("--basic-auth must be in the form user:pass")
+			}
+		}
+
+		var file *os.File
+		if out != "" {
+			var err error
+			file, err = os.OpenFile(out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+			if err != nil {
+				return fmt.Errorf("failed to open output file: %v", err)
+			}
+			defer file.Close()
+		}
+
+		var mu sync.Mutex
+		mux := http.NewServeMux()
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			if basicAuth != "" && !checkBasicAuth(r, username, password) {
+				w.Header().Set("WWW-Authenticate", `Basic realm="iterablectl"`)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				fmt.Fprintf(os.Stderr, "Rejected request from %s: invalid credentials\n", r.RemoteAddr)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxEventSize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+					fmt.Fprintf(os.Stderr, "Rejected request from %s: body larger than %d bytes\n", r.RemoteAddr, maxEventSize)
+					return
+				}
+				http.Error(w, "failed to read body", http.StatusBadRequest)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			event, data, err := iterable.ParseWebhookEvent(body)
+			if event == nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				fmt.Fprintf(os.Stderr, "Rejected request from %s: %v\n", r.RemoteAddr, err)
+				return
+			}
+
+			printEvent(event, data)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+
+			if file != nil {
+				var line bytes.Buffer
+				if err := json.Compact(&line, body); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to write event to %s: %v\n", out, err)
+				} else {
+					line.WriteByte('\n')
+					if _, err := file.Write(line.Bytes()); err != nil {
+						fmt.Fprintf(os.Stderr, "Warning: failed to write event to %s: %v\n", out, err)
+					}
+				}
+			}
+
+			w.WriteHeader(http.StatusOK)
+		})
+
+		server := &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- server.ListenAndServe()
+		}()
+		fmt.Fprintf(os.Stderr, "Listening for webhook events on http://localhost:%d%s (Ctrl+C to stop)\n", port, path)
+
+		select {
+		case err := <-errCh:
+			return fmt.Errorf("error running server: %v", err)
+		case <-ctx.Done():
+		}
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("error stopping server: %v", err)
+		}
+
+		return nil
+	},
+}
+
+// checkBasicAuth reports whether a request carries the expected credentials
+func checkBasicAuth(r *http.Request, username, password string) bool {
+	u, p, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	userMatch := subtle.ConstantTimeCompare([]byte(u), []byte(username)) == 1
+	passMatch := subtle.ConstantTimeCompare([]byte(p), []byte(password)) == 1
+	return userMatch && passMatch
+}
+
+// printEvent prints a received event with its typed data fields, followed by
+// the data fields the type does not show so that nothing sent is hidden
+func printEvent(event *iterable.WebhookEvent, data any) {
+	recipient := event.Email
+	if recipient == "" {
+		recipient = event.UserID
+	}
+	fmt.Printf("%s  %s  %s\n", time.Now().Format(time.DateTime), event.EventName, recipient)
+
+	if jsonOutput, err := json.MarshalIndent(data, "  ", "  "); err == nil {
+		fmt.Printf("  %s\n", jsonOutput)
+	}
+
+	extra, err := iterable.ExtraDataFields(event, data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to compare data fields: %v\n", err)
+	} else if len(extra) > 0 {
+		if jsonOutput, err := json.MarshalIndent(extra, "  ", "  "); err == nil {
+			fmt.Printf("  other data fields: %s\n", jsonOutput)
+		}
+	}
+	fmt.Println()
+}
+
+func init() {
+	ListenCmd.Flags().Int("port", 8080, "Port to listen on")
+	ListenCmd.Flags().String("path", "/", "URL path to receive events on")
+	ListenCmd.Flags().String("basic-auth", "", "Require these credentials, in the form user:pass")
+	ListenCmd.Flags().String("out", "", "NDJSON file to append received payloads to")
+}
@@ -0,0 +1,127 @@
+package webhooks
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/joinflux/iterablectl/pkg/iterable"
+	"github.com/joinflux/iterablectl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd represents the list command for webhooks
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List system webhooks",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		webhooks, err := client.GetWebhooks()
+		if err != nil {
+			return fmt.Errorf("error getting webhooks: %v", err)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "json" {
+			return utils.PrintJSON(webhooks)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tENDPOINT\tENABLED\tAUTH\tBLAST\tTRIGGERED\tCHANNELS\tMESSAGE TYPES")
+		for _, webhook := range *webhooks {
+			fmt.Fprintf(w, "%d\t%s\t%t\t%s\t%t\t%t\t%s\t%s\n",
+				webhook.ID, webhook.Endpoint, webhook.Enabled, webhook.AuthType,
+				webhook.BlastSendEnabled, webhook.TriggeredSendEnabled,
+				formatIDs(webhook.ChannelIDs), formatIDs(webhook.MessageTypeIDs))
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// UpdateCmd represents the update command for webhooks
+var UpdateCmd = &cobra.Command{
+	Use:   "update <webhookId>",
+	Short: "Update a system webhook",
+	Args:  cobra.ExactArgs(1),
+	Example: `iterablectl webhooks update 1234 --enabled=false
+iterablectl webhooks update 1234 --blast-send-enabled --triggered-send-enabled=false --channel-ids 1,2
+iterablectl webhooks update 1234 --message-type-ids ""`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey, _ := cmd.Flags().GetString("api-key")
+		client := iterable.NewClient(apiKey)
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid webhookId: %s", args[0])
+		}
+
+		flags := cmd.Flags()
+		changed := false
+		for _, name := range []string{"enabled", "blast-send-enabled", "triggered-send-enabled", "channel-ids", "message-type-ids"} {
+			changed = changed || flags.Changed(name)
+		}
+		if !changed {
+			return fmt.Errorf("nothing to update, specify at least one setting")
+		}
+
+		update := iterable.WebhookUpdate{ID: id}
+		if flags.Changed("enabled") {
+			enabled, _ := flags.GetBool("enabled")
+			update.Enabled = &enabled
+		}
+		if flags.Changed("blast-send-enabled") {
+			enabled, _ := flags.GetBool("blast-send-enabled")
+			update.BlastSendEnabled = &enabled
+		}
+		if flags.Changed("triggered-send-enabled") {
+			enabled, _ := flags.GetBool("triggered-send-enabled")
+			update.TriggeredSendEnabled = &enabled
+		}
+		if flags.Changed("channel-ids") {
+			values, _ := flags.GetStringSlice("channel-ids")
+			if update.ChannelIDs, err = utils.ParseIDs(values); err != nil {
+				return fmt.Errorf("invalid --channel-ids: %v", err)
+			}
+		}
+		if flags.Changed("message-type-ids") {
+			values, _ := flags.GetStringSlice("message-type-ids")
+			if update.MessageTypeIDs, err = utils.ParseIDs(values); err != nil {
+				return fmt.Errorf("invalid --message-type-ids: %v", err)
+			}
+		}
+
+		if err := client.UpdateWebhook(update); err != nil {
+			return fmt.Errorf("error updating webhook: %v", err)
+		}
+
+		fmt.Printf("Webhook %d successfully updated\n", id)
+		return nil
+	},
+}
+
+// formatIDs joins IDs with commas
+func formatIDs(ids []int) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	s := strconv.Itoa(ids[0])
+	for _, id := range ids[1:] {
+		s += "," + strconv.Itoa(id)
+	}
+	return s
+}
+
+func init() {
+	ListCmd.Flags().String("format", "table", "Output format: json or table (default)")
+
+	UpdateCmd.Flags().Bool("enabled", false, "Enable or disable the webhook")
+	UpdateCmd.Flags().Bool("blast-send-enabled", false, "Send events for blast campaigns")
+	UpdateCmd.Flags().Bool("triggered-send-enabled", false, "Send events for triggered campaigns")
+	UpdateCmd.Flags().StringSlice("channel-ids", nil, "Channel IDs whose events are sent (replaces the current list, pass \"\" to clear it)")
+	UpdateCmd.Flags().StringSlice("message-type-ids", nil, "Message type IDs whose events are sent (replaces the current list, pass \"\" to clear it)")
+}
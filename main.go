@@ -5,8 +5,23 @@ import (
 	"os"
 
 	"github.com/joinflux/iterablectl/cmd/campaigns"
+	"github.com/joinflux/iterablectl/cmd/catalogs"
+	"github.com/joinflux/iterablectl/cmd/commerce"
+	"github.com/joinflux/iterablectl/cmd/devices"
+	"github.com/joinflux/iterablectl/cmd/events"
+	"github.com/joinflux/iterablectl/cmd/experiments"
+	"github.com/joinflux/iterablectl/cmd/export"
+	"github.com/joinflux/iterablectl/cmd/inapp"
+	"github.com/joinflux/iterablectl/cmd/journeys"
 	"github.com/joinflux/iterablectl/cmd/lists"
+	"github.com/joinflux/iterablectl/cmd/messages"
+	"github.com/joinflux/iterablectl/cmd/send"
+	"github.com/joinflux/iterablectl/cmd/snippets"
+	"github.com/joinflux/iterablectl/cmd/subscriptions"
+	"github.com/joinflux/iterablectl/cmd/templates"
 	"github.com/joinflux/iterablectl/cmd/users"
+	"github.com/joinflux/iterablectl/cmd/webhooks"
+	"github.com/joinflux/iterablectl/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -31,15 +46,55 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().StringP("api-key", "k", os.Getenv("ITERABLE_API_KEY"), "Iterable API key (can also be set via ITERABLE_API_KEY environment variable)")
 
-	// Only mark required if it's not set via environment variable
-	if os.Getenv("ITERABLE_API_KEY") == "" {
-		rootCmd.MarkPersistentFlagRequired("api-key")
+	// The API key is required unless it's set via environment variable or
+	// the command doesn't call the Iterable API
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if !requiresAPIKey(cmd) {
+			return nil
+		}
+		if apiKey, _ := cmd.Flags().GetString("api-key"); apiKey == "" {
+			return fmt.Errorf("an API key is required, use --api-key or set ITERABLE_API_KEY")
+		}
+		return nil
 	}
 
 	// Add subcommands
 	rootCmd.AddCommand(users.Cmd)
 	rootCmd.AddCommand(lists.Cmd)
 	rootCmd.AddCommand(campaigns.Cmd)
+	rootCmd.AddCommand(events.Cmd)
+	rootCmd.AddCommand(commerce.Cmd)
+	rootCmd.AddCommand(templates.Cmd)
+	rootCmd.AddCommand(send.Cmd)
+	rootCmd.AddCommand(catalogs.Cmd)
+	rootCmd.AddCommand(export.Cmd)
+	rootCmd.AddCommand(subscriptions.Cmd)
+	rootCmd.AddCommand(devices.Cmd)
+	rootCmd.AddCommand(journeys.Cmd)
+	rootCmd.AddCommand(inapp.Cmd)
+	rootCmd.AddCommand(messages.Cmd)
+	rootCmd.AddCommand(snippets.Cmd)
+	rootCmd.AddCommand(experiments.Cmd)
+	rootCmd.AddCommand(webhooks.Cmd)
+}
+
+// requiresAPIKey reports whether a command calls the Iterable API. Cobra's
+// built-in help and completion commands and commands annotated with
+// utils.NoAPIKeyAnnotation run without a key.
+func requiresAPIKey(cmd *cobra.Command) bool {
+	if cmd == rootCmd || cmd.Annotations[utils.NoAPIKeyAnnotation] == "true" {
+		return false
+	}
+
+	top := cmd
+	for top.HasParent() && top.Parent() != rootCmd {
+		top = top.Parent()
+	}
+	switch top.Name() {
+	case "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return false
+	}
+	return true
 }
 
 func main() {
@@ -0,0 +1,128 @@
+package iterable
+
+import (
+	"fmt"
+	"time"
+)
+
+// campaignTimeLayout is the date format expected when scheduling campaigns
+const campaignTimeLayout = "2006-01-02 15:04:05"
+
+// CreateCampaignRequest represents a request to create a blast campaign
+type CreateCampaignRequest struct {
+	Name               string
+	TemplateID         int
+	ListIDs            []int
+	SuppressionListIDs []int
+	SendAt             time.Time // zero sends immediately
+	SendMode           string    // ProjectTimeZone or RecipientTimeZone
+	DataFields         map[string]any
+}
+
+// CreateCampaign creates a blast campaign and returns its ID. The campaign is
+// scheduled at SendAt, or sent immediately if SendAt is zero.
+func (c *Client) CreateCampaign(campaign CreateCampaignRequest) (int, error) {
+	body := map[string]any{
+		"name":       campaign.Name,
+		"templateId": campaign.TemplateID,
+		"listIds":    campaign.ListIDs,
+	}
+	if len(campaign.SuppressionListIDs) > 0 {
+		body["suppressionListIds"] = campaign.SuppressionListIDs
+	}
+	if !campaign.SendAt.IsZero() {
+		body["sendAt"] = campaign.SendAt.UTC().Format(campaignTimeLayout)
+	}
+	if campaign.SendMode != "" {
+		body["sendMode"] = campaign.SendMode
+	}
+	if len(campaign.DataFields) > 0 {
+		body["dataFields"] = campaign.DataFields
+	}
+
+	req, err := c.newRequest("POST", "campaigns/create", body)
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		CampaignID int `json:"campaignId"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.CampaignID, nil
+}
+
+// TriggerCampaign sends an active triggered campaign to the given lists
+func (c *Client) TriggerCampaign(campaignID int, listIDs, suppressionListIDs []int, dataFields map[string]any) error {
+	body := map[string]any{
+		"campaignId": campaignID,
+		"listIds":    listIDs,
+	}
+	if len(suppressionListIDs) > 0 {
+		body["suppressionListIds"] = suppressionListIDs
+	}
+	if len(dataFields) > 0 {
+		body["dataFields"] = dataFields
+	}
+
+	return c.campaignAction("campaigns/trigger", body)
+}
+
+// AbortCampaign stops a campaign that is currently sending
+func (c *Client) AbortCampaign(campaignID int) error {
+	return c.campaignAction("campaigns/abort", map[string]any{"campaignId": campaignID})
+}
+
+// CancelCampaign cancels a scheduled or recurring campaign before it sends
+func (c *Client) CancelCampaign(campaignID int) error {
+	return c.campaignAction("campaigns/cancel", map[string]any{"campaignId": campaignID})
+}
+
+// ActivateTriggeredCampaign activates a triggered campaign so it can be
+// sent with TriggerCampaign
+func (c *Client) ActivateTriggeredCampaign(campaignID int) error {
+	return c.campaignAction("campaigns/activateTriggered", map[string]any{"campaignId": campaignID})
+}
+
+// GetChildCampaigns retrieves the campaigns created by a recurring campaign
+func (c *Client) GetChildCampaigns(recurringCampaignID int) (*[]Campaign, error) {
+	path := fmt.Sprintf("campaigns/recurring/%d/childCampaigns", recurringCampaignID)
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Campaigns []Campaign `json:"campaigns"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Campaigns, nil
+}
+
+// campaignAction posts a campaign state change and checks the response code
+func (c *Client) campaignAction(path string, body map[string]any) error {
+	req, err := c.newRequest("POST", path, body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to update campaign: %v", response)
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package iterable
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// CatalogItem represents an item in an Iterable catalog
+type CatalogItem struct {
+	CatalogName  string         `json:"catalogName"`
+	ItemID       string         `json:"itemId"`
+	LastModified int64          `json:"lastModified"` // milliseconds
+	Size         int            `json:"size"`
+	Value        map[string]any `json:"value"`
+}
+
+// CatalogFieldMappings represents the schema of a catalog
+type CatalogFieldMappings struct {
+	DefinedMappings map[string]string `json:"definedMappings"`
+	UndefinedFields []string          `json:"undefinedFields,omitempty"`
+}
+
+// doCatalog sends a catalog API request. Catalog responses wrap their result
+// in the params of a code/msg envelope, which is decoded into v.
+func (c *Client) doCatalog(req *http.Request, v any) error {
+	var response struct {
+		Code    string          `json:"code"`
+		Message string          `json:"msg"`
+		Params  json.RawMessage `json:"params"`
+	}
+	err := c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return &APIError{Code: response.Code, Message: response.Message}
+	}
+
+	if v != nil && len(response.Params) > 0 {
+		if err := json.Unmarshal(response.Params, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// catalogPath builds a catalog API path from escaped path segments
+func catalogPath(segments ...string) string {
+	path := "catalogs"
+	for _, s := range segments {
+		path += "/" + url.PathEscape(s)
+	}
+	return path
+}
+
+// GetCatalogs retrieves the names of all catalogs in the project
+func (c *Client) GetCatalogs() ([]string, error) {
+	var names []string
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("pageSize", "100")
+		req, err := c.newRequest("GET", fmt.Sprintf("catalogs?%s", query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var params struct {
+			CatalogNames []struct {
+				Name string `json:"name"`
+			} `json:"catalogNames"`
+			NextPageURL string `json:"nextPageUrl"`
+		}
+		if err := c.doCatalog(req, &params); err != nil {
+			return nil, err
+		}
+
+		for _, catalog := range params.CatalogNames {
+			names = append(names, catalog.Name)
+		}
+		if params.NextPageURL == "" || len(params.CatalogNames) == 0 {
+			return names, nil
+		}
+	}
+}
+
+// CreateCatalog creates an empty catalog
+func (c *Client) CreateCatalog(name string) error {
+	req, err := c.newRequest("POST", catalogPath(name), nil)
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
+
+// DeleteCatalog deletes a catalog and all of its items
+func (c *Client) DeleteCatalog(name string) error {
+	req, err := c.newRequest("DELETE", catalogPath(name), nil)
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
+
+// GetCatalogItems retrieves a page of items from a catalog. Pages start at 1.
+// It also returns whether more pages are available.
+func (c *Client) GetCatalogItems(catalog string, page, pageSize int) ([]CatalogItem, bool, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("pageSize", strconv.Itoa(pageSize))
+	path := fmt.Sprintf("%s?%s", catalogPath(catalog, "items"), query.Encode())
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var params struct {
+		Items       []CatalogItem `json:"catalogItemsWithProperties"`
+		NextPageURL string        `json:"nextPageUrl"`
+	}
+	if err := c.doCatalog(req, &params); err != nil {
+		return nil, false, err
+	}
+
+	return params.Items, params.NextPageURL != "", nil
+}
+
+// GetCatalogItem retrieves a single catalog item
+func (c *Client) GetCatalogItem(catalog, itemID string) (*CatalogItem, error) {
+	req, err := c.newRequest("GET", catalogPath(catalog, "items", itemID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var item CatalogItem
+	if err := c.doCatalog(req, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// PutCatalogItem creates or replaces a catalog item
+func (c *Client) PutCatalogItem(catalog, itemID string, value map[string]any) error {
+	req, err := c.newRequest("PUT", catalogPath(catalog, "items", itemID), map[string]any{"value": value})
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
+
+// PatchCatalogItem updates fields of a catalog item, creating it if needed
+func (c *Client) PatchCatalogItem(catalog, itemID string, update map[string]any) error {
+	req, err := c.newRequest("PATCH", catalogPath(catalog, "items", itemID), map[string]any{"update": update})
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
+
+// DeleteCatalogItem deletes a catalog item
+func (c *Client) DeleteCatalogItem(catalog, itemID string) error {
+	req, err := c.newRequest("DELETE", catalogPath(catalog, "items", itemID), nil)
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
+
+// BulkPutCatalogItems creates or replaces up to 1000 catalog items, keyed by
+// item ID. With replaceUploadedFieldsOnly, fields not present in the upload
+// are kept on existing items.
+func (c *Client) BulkPutCatalogItems(catalog string, items map[string]map[string]any, replaceUploadedFieldsOnly bool) error {
+	body := map[string]any{
+		"documents":                 items,
+		"replaceUploadedFieldsOnly": replaceUploadedFieldsOnly,
+	}
+	req, err := c.newRequest("POST", catalogPath(catalog, "items"), body)
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
+
+// GetCatalogFieldMappings retrieves the field types defined for a catalog
+func (c *Client) GetCatalogFieldMappings(catalog string) (*CatalogFieldMappings, error) {
+	req, err := c.newRequest("GET", catalogPath(catalog, "fieldMappings"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings CatalogFieldMappings
+	if err := c.doCatalog(req, &mappings); err != nil {
+		return nil, err
+	}
+
+	return &mappings, nil
+}
+
+// SetCatalogFieldMappings defines field types for a catalog. Existing
+// mappings cannot be changed.
+func (c *Client) SetCatalogFieldMappings(catalog string, mappings map[string]string) error {
+	type mappingUpdate struct {
+		FieldName string `json:"fieldName"`
+		FieldType string `json:"fieldType"`
+	}
+	updates := make([]mappingUpdate, 0, len(mappings))
+	for name, fieldType := range mappings {
+		updates = append(updates, mappingUpdate{FieldName: name, FieldType: fieldType})
+	}
+	sort.Slice(updates, func(i, j int) bool { return updates[i].FieldName < updates[j].FieldName })
+
+	req, err := c.newRequest("PUT", catalogPath(catalog, "fieldMappings"), map[string]any{"mappingsUpdates": updates})
+	if err != nil {
+		return err
+	}
+	return c.doCatalog(req, nil)
+}
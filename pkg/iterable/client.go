@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 const (
@@ -103,6 +105,32 @@ func (c *Client) doPlain(req *http.Request) (*[]byte, error) {
 	return &bodyBytes, nil
 }
 
+// doRaw sends an HTTP request for a non-JSON response, such as a CSV report,
+// and returns the response body. Unlike doPlain, error statuses are returned
+// as errors rather than as the body.
+func (c *Client) doRaw(req *http.Request) ([]byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		var apiErr APIError
+		if err = json.Unmarshal(bodyBytes, &apiErr); err != nil {
+			return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, bodyBytes)
+		}
+		return nil, &apiErr
+	}
+
+	return bodyBytes, nil
+}
+
 // do sends an API request and returns the response
 func (c *Client) do(req *http.Request, v any) error {
 	resp, err := c.httpClient.Do(req)
@@ -188,6 +216,25 @@ func (c *Client) GetUser(email string) (*User, error) {
 	return &response.User, nil
 }
 
+// GetUserByID retrieves a user by user ID from Iterable
+func (c *Client) GetUserByID(userID string) (*User, error) {
+	path := fmt.Sprintf("users/byUserId/%s", userID)
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		User User `json:"user"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.User, nil
+}
+
 // UserUpdateRequest represents a request to update a user's profile in Iterable
 type UserUpdateRequest struct {
 	Email              string         `json:"email,omitempty"`
@@ -218,6 +265,40 @@ func (c *Client) UpdateUser(user UserUpdateRequest) error {
 	return nil
 }
 
+// BulkUpdateUser represents a single user entry in a bulk update request
+type BulkUpdateUser struct {
+	Email              string         `json:"email,omitempty"`
+	UserID             string         `json:"userId,omitempty"`
+	DataFields         map[string]any `json:"dataFields,omitempty"`
+	PreferUserId       bool           `json:"preferUserId,omitempty"`
+	MergeNestedObjects bool           `json:"mergeNestedObjects,omitempty"`
+}
+
+// BulkUpdateResponse represents the result of a bulk user update
+type BulkUpdateResponse struct {
+	SuccessCount   int      `json:"successCount"`
+	FailCount      int      `json:"failCount"`
+	InvalidEmails  []string `json:"invalidEmails,omitempty"`
+	InvalidUserIds []string `json:"invalidUserIds,omitempty"`
+}
+
+// BulkUpdateUsers updates multiple Iterable user profiles in a single request
+func (c *Client) BulkUpdateUsers(users []BulkUpdateUser) (*BulkUpdateResponse, error) {
+	body := map[string]any{"users": users}
+	req, err := c.newRequest("POST", "users/bulkUpdate", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response BulkUpdateResponse
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // GetLists retrieves the lists associated with the Iterable account
 func (c *Client) GetLists() (*[]List, error) {
 	req, err := c.newRequest("GET", "lists", nil)
@@ -295,6 +376,50 @@ func (c *Client) GetCampaigns() (*[]Campaign, error) {
 	return &response.Campaigns, nil
 }
 
+// GetCampaign retrieves a single campaign by ID
+func (c *Client) GetCampaign(id int) (*Campaign, error) {
+	path := fmt.Sprintf("campaigns/%d", id)
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var campaign Campaign
+	err = c.do(req, &campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign, nil
+}
+
+// GetCampaignMetrics retrieves metrics for the given campaigns. A zero start
+// or end time leaves that side of the date range open.
+func (c *Client) GetCampaignMetrics(campaignIDs []int, start, end time.Time) (*Metrics, error) {
+	query := url.Values{}
+	for _, id := range campaignIDs {
+		query.Add("campaignId", strconv.Itoa(id))
+	}
+	if !start.IsZero() {
+		query.Set("startDateTime", start.UTC().Format(metricsTimeLayout))
+	}
+	if !end.IsZero() {
+		query.Set("endDateTime", end.UTC().Format(metricsTimeLayout))
+	}
+	path := fmt.Sprintf("campaigns/metrics?%s", query.Encode())
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRaw(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMetrics(body)
+}
+
 // DeleteUser removes a user from Iterable by their email address
 func (c *Client) DeleteUser(email string) error {
 	path := fmt.Sprintf("users/%s", email)
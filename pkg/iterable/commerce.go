@@ -0,0 +1,96 @@
+package iterable
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CommerceItem represents an item in a shopping cart or purchase
+type CommerceItem struct {
+	ID          string         `json:"id"`
+	SKU         string         `json:"sku,omitempty"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Categories  []string       `json:"categories,omitempty"`
+	Price       *float64       `json:"price"`
+	Quantity    int            `json:"quantity"`
+	ImageURL    string         `json:"imageUrl,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	DataFields  map[string]any `json:"dataFields,omitempty"`
+}
+
+// Validate checks that the fields required by Iterable are set
+func (i CommerceItem) Validate() error {
+	var errs []error
+	if i.ID == "" {
+		errs = append(errs, errors.New("id is required"))
+	}
+	if i.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	if i.Price == nil {
+		errs = append(errs, errors.New("price is required"))
+	} else if *i.Price < 0 {
+		errs = append(errs, errors.New("price cannot be negative"))
+	}
+	if i.Quantity <= 0 {
+		errs = append(errs, errors.New("quantity must be greater than zero"))
+	}
+	return errors.Join(errs...)
+}
+
+// Purchase represents a completed purchase for an Iterable user
+type Purchase struct {
+	ID         string            `json:"id,omitempty"`
+	User       UserUpdateRequest `json:"user"`
+	Items      []CommerceItem    `json:"items"`
+	CampaignID int               `json:"campaignId,omitempty"`
+	TemplateID int               `json:"templateId,omitempty"`
+	Total      float64           `json:"total"`
+	CreatedAt  int64             `json:"createdAt,omitempty"` // seconds
+	DataFields map[string]any    `json:"dataFields,omitempty"`
+}
+
+// TrackPurchase tracks a purchase and clears the user's shopping cart
+func (c *Client) TrackPurchase(purchase Purchase) error {
+	req, err := c.newRequest("POST", "commerce/trackPurchase", purchase)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to track purchase: %v", response)
+	}
+
+	return nil
+}
+
+// UpdateCart replaces the items in a user's shopping cart
+func (c *Client) UpdateCart(user UserUpdateRequest, items []CommerceItem) error {
+	body := map[string]any{
+		"user":  user,
+		"items": items,
+	}
+	req, err := c.newRequest("POST", "commerce/updateCart", body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to update cart: %v", response)
+	}
+
+	return nil
+}
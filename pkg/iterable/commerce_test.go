@@ -0,0 +1,34 @@
+package iterable
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCommerceItemValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		item    string
+		wantErr bool
+	}{
+		{"valid", `{"id":"1","name":"Shirt","price":19.95,"quantity":1}`, false},
+		{"free item", `{"id":"1","name":"Sample","price":0,"quantity":1}`, false},
+		{"missing price", `{"id":"1","name":"Shirt","quantity":1}`, true},
+		{"negative price", `{"id":"1","name":"Shirt","price":-1,"quantity":1}`, true},
+		{"missing id", `{"name":"Shirt","price":1,"quantity":1}`, true},
+		{"missing name", `{"id":"1","price":1,"quantity":1}`, true},
+		{"zero quantity", `{"id":"1","name":"Shirt","price":1}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var item CommerceItem
+			if err := json.Unmarshal([]byte(tt.item), &item); err != nil {
+				t.Fatal(err)
+			}
+			if err := item.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package iterable
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Device represents a mobile device registered for push notifications
+type Device struct {
+	Token                string         `json:"token"`
+	Platform             string         `json:"platform"` // APNS, APNS_SANDBOX or GCM
+	ApplicationName      string         `json:"applicationName"`
+	EndpointEnabled      bool           `json:"endpointEnabled,omitempty"`
+	NotificationsEnabled bool           `json:"notificationsEnabled,omitempty"`
+	DataFields           map[string]any `json:"dataFields,omitempty"`
+}
+
+// Devices returns the devices stored in the user's profile
+func (u *User) Devices() ([]Device, error) {
+	var devices []Device
+	if err := decodeDataField(u.DataFields, "devices", &devices); err != nil {
+		return nil, fmt.Errorf("invalid devices in user profile: %v", err)
+	}
+	return devices, nil
+}
+
+// BrowserTokens returns the web push tokens stored in the user's profile
+func (u *User) BrowserTokens() ([]string, error) {
+	var tokens []string
+	if err := decodeDataField(u.DataFields, "browserTokens", &tokens); err != nil {
+		return nil, fmt.Errorf("invalid browser tokens in user profile: %v", err)
+	}
+	return tokens, nil
+}
+
+// decodeDataField decodes a profile data field into v, leaving v unchanged
+// if the field is not set
+func decodeDataField(dataFields map[string]any, field string, v any) error {
+	value, ok := dataFields[field]
+	if !ok || value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// RegisterDeviceToken registers a push token for a user by email or user ID.
+// With preferUserID, a user is created if the user ID does not exist yet.
+func (c *Client) RegisterDeviceToken(email, userID string, preferUserID bool, device Device) error {
+	body := map[string]any{"device": device}
+	if email != "" {
+		body["email"] = email
+	}
+	if userID != "" {
+		body["userId"] = userID
+	}
+	if preferUserID {
+		body["preferUserId"] = true
+	}
+
+	req, err := c.newRequest("POST", "users/registerDeviceToken", body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to register device token: %v", response)
+	}
+
+	return nil
+}
+
+// RegisterBrowserToken registers a web push token for a user by email or
+// user ID
+func (c *Client) RegisterBrowserToken(email, userID, token string) error {
+	body := map[string]any{"browserToken": token}
+	if email != "" {
+		body["email"] = email
+	}
+	if userID != "" {
+		body["userId"] = userID
+	}
+
+	req, err := c.newRequest("POST", "users/registerBrowserToken", body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to register browser token: %v", response)
+	}
+
+	return nil
+}
+
+// DisableDevice disables push notifications for a token. If no user is
+// given, the token is disabled for all users.
+func (c *Client) DisableDevice(token, email, userID string) error {
+	body := map[string]any{"token": token}
+	if email != "" {
+		body["email"] = email
+	}
+	if userID != "" {
+		body["userId"] = userID
+	}
+
+	req, err := c.newRequest("POST", "users/disableDevice", body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to disable device: %v", response)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Event represents a custom event tracked for an Iterable user
+type Event struct {
+	Email      string         `json:"email,omitempty"`
+	UserID     string         `json:"userId,omitempty"`
+	EventName  string         `json:"eventName"`
+	ID         string         `json:"id,omitempty"`
+	CreatedAt  int64          `json:"createdAt,omitempty"` // seconds
+	DataFields map[string]any `json:"dataFields,omitempty"`
+	CampaignID int            `json:"campaignId,omitempty"`
+	TemplateID int            `json:"templateId,omitempty"`
+}
+
+// BulkTrackResponse represents the result of tracking events in bulk
+type BulkTrackResponse struct {
+	SuccessCount         int      `json:"successCount"`
+	FailCount            int      `json:"failCount"`
+	InvalidEmails        []string `json:"invalidEmails,omitempty"`
+	InvalidUserIds       []string `json:"invalidUserIds,omitempty"`
+	DisallowedEventNames []string `json:"disallowedEventNames,omitempty"`
+	FilteredOutFields    []string `json:"filteredOutFields,omitempty"`
+}
+
+// TrackEvent tracks a custom event for an Iterable user
+func (c *Client) TrackEvent(event Event) error {
+	req, err := c.newRequest("POST", "events/track", event)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to track event: %v", response)
+	}
+
+	return nil
+}
+
+// TrackEvents tracks multiple custom events in a single request
+func (c *Client) TrackEvents(events []Event) (*BulkTrackResponse, error) {
+	body := map[string]any{"events": events}
+	req, err := c.newRequest("POST", "events/trackBulk", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response BulkTrackResponse
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UserEvent represents an event in a user's history. Besides the standard
+// fields such as eventName, eventType, createdAt, campaignId and templateId,
+// events carry their data fields at the top level.
+type UserEvent map[string]any
+
+// GetUserEvents retrieves the most recent events for a user by email
+func (c *Client) GetUserEvents(email string, limit int) (*[]UserEvent, error) {
+	return c.getUserEvents(fmt.Sprintf("events/%s", url.PathEscape(email)), limit)
+}
+
+// GetUserEventsByID retrieves the most recent events for a user by user ID
+func (c *Client) GetUserEventsByID(userID string, limit int) (*[]UserEvent, error) {
+	return c.getUserEvents(fmt.Sprintf("events/byUserId/%s", url.PathEscape(userID)), limit)
+}
+
+func (c *Client) getUserEvents(path string, limit int) (*[]UserEvent, error) {
+	if limit > 0 {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(limit))
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Events []UserEvent `json:"events"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Events, nil
+}
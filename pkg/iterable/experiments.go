@@ -0,0 +1,141 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExperimentMetricsOpts selects the experiments to get metrics for. A zero
+// start or end time leaves that side of the date range open.
+type ExperimentMetricsOpts struct {
+	ExperimentIDs []int
+	CampaignIDs   []int
+	Start         time.Time
+	End           time.Time
+}
+
+// ExperimentVariant represents the metrics of one variant of an experiment
+type ExperimentVariant struct {
+	ExperimentID int                `json:"experimentId"`
+	TemplateID   int                `json:"templateId,omitempty"`
+	Name         string             `json:"name,omitempty"`
+	Control      bool               `json:"control"`
+	Metrics      map[string]float64 `json:"metrics"`
+}
+
+// Metric returns the value of a metric, matching its name loosely so that
+// "Total Email Sends" and "totalEmailSends" are the same metric
+func (v *ExperimentVariant) Metric(name string) (float64, bool) {
+	key := normalizeColumn(name)
+	for k, value := range v.Metrics {
+		if normalizeColumn(k) == key {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// normalizeColumn lowercases a column name and drops separators
+func normalizeColumn(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// Columns identifying a variant rather than measuring it
+var (
+	experimentIDColumns = []string{"experimentid"}
+	templateIDColumns   = []string{"templateid"}
+	variantNameColumns  = []string{"variantname", "creativename", "templatename", "name"}
+	controlColumns      = []string{"iscontrol", "control"}
+)
+
+// GetExperimentMetrics retrieves metrics for experiments selected by
+// experiment or campaign ID, one row per variant
+func (c *Client) GetExperimentMetrics(opts ExperimentMetricsOpts) (*Metrics, error) {
+	query := url.Values{}
+	for _, id := range opts.ExperimentIDs {
+		query.Add("experimentId", strconv.Itoa(id))
+	}
+	for _, id := range opts.CampaignIDs {
+		query.Add("campaignId", strconv.Itoa(id))
+	}
+	if !opts.Start.IsZero() {
+		query.Set("startDateTime", opts.Start.UTC().Format(metricsTimeLayout))
+	}
+	if !opts.End.IsZero() {
+		query.Set("endDateTime", opts.End.UTC().Format(metricsTimeLayout))
+	}
+	path := fmt.Sprintf("experiments/metrics?%s", query.Encode())
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRaw(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMetrics(body)
+}
+
+// ExperimentVariants converts an experiment metrics report into variants.
+// Numeric columns become metrics; empty or non-numeric values are skipped.
+func ExperimentVariants(m *Metrics) ([]ExperimentVariant, error) {
+	roles := make(map[int]string, len(m.Columns))
+	for i, column := range m.Columns {
+		key := normalizeColumn(column)
+		switch {
+		case slices.Contains(experimentIDColumns, key):
+			roles[i] = "experiment"
+		case slices.Contains(templateIDColumns, key):
+			roles[i] = "template"
+		case slices.Contains(variantNameColumns, key):
+			roles[i] = "name"
+		case slices.Contains(controlColumns, key):
+			roles[i] = "control"
+		}
+	}
+
+	variants := make([]ExperimentVariant, 0, len(m.Rows))
+	for row, record := range m.Rows {
+		variant := ExperimentVariant{Metrics: map[string]float64{}}
+		for i, value := range record {
+			if i >= len(m.Columns) {
+				break
+			}
+			value = strings.TrimSpace(value)
+
+			switch roles[i] {
+			case "experiment":
+				id, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("row %d: invalid experiment ID %q", row+1, value)
+				}
+				variant.ExperimentID = id
+			case "template":
+				variant.TemplateID, _ = strconv.Atoi(value)
+			case "name":
+				variant.Name = value
+			case "control":
+				variant.Control, _ = strconv.ParseBool(value)
+			default:
+				if f, err := strconv.ParseFloat(value, 64); err == nil {
+					variant.Metrics[m.Columns[i]] = f
+				}
+			}
+		}
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
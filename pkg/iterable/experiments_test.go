@@ -0,0 +1,44 @@
+package iterable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExperimentVariants(t *testing.T) {
+	metrics, err := parseMetrics([]byte("experimentId,templateId,Variant Name,isControl,Total Email Sends,Unique Email Clicks,Revenue\n" +
+		"1,10,Control,true,1000,50,\n" +
+		"1,11,Short subject,false,1000,80,12.5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	variants, err := ExperimentVariants(metrics)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ExperimentVariant{
+		{ExperimentID: 1, TemplateID: 10, Name: "Control", Control: true,
+			Metrics: map[string]float64{"Total Email Sends": 1000, "Unique Email Clicks": 50}},
+		{ExperimentID: 1, TemplateID: 11, Name: "Short subject",
+			Metrics: map[string]float64{"Total Email Sends": 1000, "Unique Email Clicks": 80, "Revenue": 12.5}},
+	}
+	if !reflect.DeepEqual(variants, want) {
+		t.Errorf("got %+v, want %+v", variants, want)
+	}
+
+	if v, ok := variants[1].Metric("unique_email_clicks"); !ok || v != 80 {
+		t.Errorf("Metric() = %v, %v, want 80, true", v, ok)
+	}
+	if _, ok := variants[0].Metric("Revenue"); ok {
+		t.Errorf("expected empty values to be skipped")
+	}
+}
+
+func TestExperimentVariantsInvalidID(t *testing.T) {
+	metrics := &Metrics{Columns: []string{"experimentId"}, Rows: [][]string{{"abc"}}}
+	if _, err := ExperimentVariants(metrics); err == nil {
+		t.Errorf("expected an error for an invalid experiment ID")
+	}
+}
@@ -0,0 +1,240 @@
+package iterable
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportTimeLayout is the date format expected by the export endpoints
+const exportTimeLayout = "2006-01-02 15:04:05"
+
+// ExportDataOpts represents the options for a data export
+type ExportDataOpts struct {
+	DataType   string // e.g. user, emailSend, emailOpen
+	Format     string // csv or json
+	Start      time.Time
+	End        time.Time
+	OnlyFields []string
+	OmitFields []string
+	CampaignID int
+}
+
+// ExportData exports data of the given type and streams the response to w.
+// JSON exports are written as newline-delimited JSON.
+func (c *Client) ExportData(opts ExportDataOpts, w io.Writer) error {
+	if opts.Format != "csv" && opts.Format != "json" {
+		return fmt.Errorf("invalid export format %q, must be csv or json", opts.Format)
+	}
+
+	query := url.Values{}
+	query.Set("dataTypeName", opts.DataType)
+	if !opts.Start.IsZero() {
+		query.Set("startDateTime", opts.Start.UTC().Format(exportTimeLayout))
+	}
+	if !opts.End.IsZero() {
+		query.Set("endDateTime", opts.End.UTC().Format(exportTimeLayout))
+	}
+	for _, field := range opts.OnlyFields {
+		query.Add("onlyFields", field)
+	}
+	if len(opts.OmitFields) > 0 {
+		query.Set("omitFields", strings.Join(opts.OmitFields, ","))
+	}
+	if opts.CampaignID != 0 {
+		query.Set("campaignId", strconv.Itoa(opts.CampaignID))
+	}
+
+	path := fmt.Sprintf("export/data.%s?%s", opts.Format, query.Encode())
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doStream(req)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if _, err := io.Copy(w, body); err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	return nil
+}
+
+// doStream sends an HTTP request and returns the response body for the
+// caller to read and close, so large responses are not held in memory.
+func (c *Client) doStream(req *http.Request) (io.ReadCloser, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		var apiErr APIError
+		if err = json.NewDecoder(resp.Body).Decode(&apiErr); err != nil {
+			return nil, fmt.Errorf("API request failed with status %d: %w", resp.StatusCode, err)
+		}
+		return nil, &apiErr
+	}
+
+	return resp.Body, nil
+}
+
+// exportOutputFormats maps export formats to the output formats of export jobs
+var exportOutputFormats = map[string]string{
+	"csv":  "text/csv",
+	"json": "application/x-json-stream",
+}
+
+// ExportJob represents the status of an asynchronous export job
+type ExportJob struct {
+	ID                 int    `json:"jobId"`
+	State              string `json:"jobState"`
+	DataTypeName       string `json:"dataTypeName,omitempty"`
+	BytesExported      int64  `json:"bytesExported,omitempty"`
+	ScheduledStartTime string `json:"scheduledStartTime,omitempty"`
+	StartTime          string `json:"startTime,omitempty"`
+	EndTime            string `json:"endTime,omitempty"`
+	Error              string `json:"error,omitempty"`
+}
+
+// Done reports whether the job has stopped running
+func (j *ExportJob) Done() bool {
+	switch j.State {
+	case "completed", "failed", "cancelled":
+		return true
+	}
+	return false
+}
+
+// ExportFile represents a result file of an export job
+type ExportFile struct {
+	File string `json:"file"`
+	URL  string `json:"url"`
+}
+
+// StartExport starts an asynchronous export job and returns its ID
+func (c *Client) StartExport(opts ExportDataOpts) (int, error) {
+	outputFormat, ok := exportOutputFormats[opts.Format]
+	if !ok {
+		return 0, fmt.Errorf("invalid export format %q, must be csv or json", opts.Format)
+	}
+
+	body := map[string]any{
+		"dataTypeName": opts.DataType,
+		"outputFormat": outputFormat,
+	}
+	if !opts.Start.IsZero() {
+		body["startDateTime"] = opts.Start.UTC().Format(exportTimeLayout)
+	}
+	if !opts.End.IsZero() {
+		body["endDateTime"] = opts.End.UTC().Format(exportTimeLayout)
+	}
+	if len(opts.OnlyFields) > 0 {
+		body["onlyFields"] = opts.OnlyFields
+	}
+	if len(opts.OmitFields) > 0 {
+		body["omitFields"] = strings.Join(opts.OmitFields, ",")
+	}
+	if opts.CampaignID != 0 {
+		body["campaignId"] = opts.CampaignID
+	}
+
+	req, err := c.newRequest("POST", "export/start", body)
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		JobID int `json:"jobId"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.JobID, nil
+}
+
+// GetExportJob retrieves the status of an export job
+func (c *Client) GetExportJob(jobID int) (*ExportJob, error) {
+	req, err := c.newRequest("GET", fmt.Sprintf("export/%d/status", jobID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job ExportJob
+	err = c.do(req, &job)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// GetExportFiles retrieves the result files of a completed export job
+func (c *Client) GetExportFiles(jobID int) ([]ExportFile, error) {
+	var files []ExportFile
+	startAfter := ""
+	for {
+		path := fmt.Sprintf("export/%d/files", jobID)
+		if startAfter != "" {
+			query := url.Values{}
+			query.Set("startAfter", startAfter)
+			path = fmt.Sprintf("%s?%s", path, query.Encode())
+		}
+		req, err := c.newRequest("GET", path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			ExportTruncated bool         `json:"exportTruncated"`
+			Files           []ExportFile `json:"files"`
+		}
+		err = c.do(req, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, response.Files...)
+		if !response.ExportTruncated || len(response.Files) == 0 {
+			return files, nil
+		}
+		startAfter = response.Files[len(response.Files)-1].File
+	}
+}
+
+// Download streams the content of a pre-signed file URL, such as an export
+// file, to w. The API key is not sent along.
+func (c *Client) Download(rawURL string, w io.Writer) error {
+	req, err := http.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("download failed with status %d", resp.StatusCode)
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package iterable
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// GetUserFields retrieves the user profile fields defined in the project,
+// keyed by dot-separated field path with the field type as value
+func (c *Client) GetUserFields() (map[string]string, error) {
+	req, err := c.newRequest("GET", "users/getFields", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Fields map[string]string `json:"fields"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Fields, nil
+}
+
+// ValidateDataFields checks data fields against the project field schema
+// returned by GetUserFields. It returns one error per unknown field or
+// value that does not match the field type. Null values are always accepted
+// since they clear the field.
+//
+// String values of long, double and boolean fields, as passed with
+// --data-field, are converted to the field type in place so that the
+// request carries the type the schema expects.
+func ValidateDataFields(schema map[string]string, data map[string]any) []error {
+	var errs []error
+	validateFields(schema, "", data, &errs)
+	return errs
+}
+
+// dateLayouts are the date formats Iterable accepts for date fields
+var dateLayouts = []string{
+	"2006-01-02 15:04:05 -07:00",
+	"2006-01-02 15:04:05 -0700",
+	time.DateTime,
+	time.DateOnly,
+	time.RFC3339Nano,
+}
+
+func validateFields(schema map[string]string, prefix string, data map[string]any, errs *[]error) {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		value := data[k]
+		fieldType, ok := schema[path]
+		if !ok {
+			*errs = append(*errs, fmt.Errorf("unknown field %q", path))
+			continue
+		}
+		if value == nil {
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok && fieldType == "object" {
+			validateFields(schema, path, nested, errs)
+			continue
+		}
+
+		if items, ok := value.([]any); ok {
+			for i, item := range items {
+				converted, ok := convertFieldValue(fieldType, item)
+				if !ok {
+					*errs = append(*errs, fmt.Errorf("field %q expects %s, got %v", path, fieldType, item))
+					break
+				}
+				items[i] = converted
+			}
+			continue
+		}
+
+		converted, ok := convertFieldValue(fieldType, value)
+		if !ok {
+			*errs = append(*errs, fmt.Errorf("field %q expects %s, got %v", path, fieldType, value))
+			continue
+		}
+		data[k] = converted
+	}
+}
+
+// convertFieldValue returns v as a value of the given field type, and false
+// if it cannot be stored in such a field. Strings are parsed for long,
+// double and boolean fields, as values passed with --data-field are always
+// strings.
+func convertFieldValue(fieldType string, v any) (any, bool) {
+	switch fieldType {
+	case "string":
+		_, ok := v.(string)
+		return v, ok
+	case "date":
+		s, ok := v.(string)
+		if !ok {
+			return v, false
+		}
+		for _, layout := range dateLayouts {
+			if _, err := time.Parse(layout, s); err == nil {
+				return v, true
+			}
+		}
+		return v, false
+	case "long":
+		switch value := v.(type) {
+		case float64:
+			return v, value == float64(int64(value))
+		case string:
+			n, err := strconv.ParseInt(value, 10, 64)
+			return n, err == nil
+		}
+		return v, false
+	case "double":
+		switch value := v.(type) {
+		case float64:
+			return v, true
+		case string:
+			f, err := strconv.ParseFloat(value, 64)
+			return f, err == nil
+		}
+		return v, false
+	case "boolean":
+		switch value := v.(type) {
+		case bool:
+			return v, true
+		case string:
+			b, err := strconv.ParseBool(value)
+			return b, err == nil
+		}
+		return v, false
+	case "object":
+		_, ok := v.(map[string]any)
+		return v, ok
+	default:
+		// Types such as geo_location are not checked client-side
+		return v, true
+	}
+}
@@ -0,0 +1,95 @@
+package iterable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateDataFields(t *testing.T) {
+	schema := map[string]string{
+		"name":         "string",
+		"age":          "long",
+		"score":        "double",
+		"vip":          "boolean",
+		"signupDate":   "date",
+		"tags":         "string",
+		"address":      "object",
+		"address.city": "string",
+		"location":     "geo_location",
+	}
+
+	tests := []struct {
+		name    string
+		data    map[string]any
+		want    map[string]any
+		wantErr int
+	}{
+		{
+			name: "converts strings to field types",
+			data: map[string]any{"age": "42", "score": "1.5", "vip": "true"},
+			want: map[string]any{"age": int64(42), "score": 1.5, "vip": true},
+		},
+		{
+			name: "keeps typed values",
+			data: map[string]any{"age": float64(42), "score": float64(2), "vip": false, "name": "Ada"},
+			want: map[string]any{"age": float64(42), "score": float64(2), "vip": false, "name": "Ada"},
+		},
+		{
+			name: "accepts Iterable date formats",
+			data: map[string]any{"signupDate": "2025-01-02 15:04:05 +00:00"},
+			want: map[string]any{"signupDate": "2025-01-02 15:04:05 +00:00"},
+		},
+		{
+			name: "accepts ISO 8601 dates",
+			data: map[string]any{"signupDate": "2025-01-02T15:04:05.000Z"},
+			want: map[string]any{"signupDate": "2025-01-02T15:04:05.000Z"},
+		},
+		{
+			name:    "rejects invalid dates",
+			data:    map[string]any{"signupDate": "yesterday"},
+			wantErr: 1,
+		},
+		{
+			name:    "rejects mismatched types",
+			data:    map[string]any{"age": "forty", "score": "high", "vip": "maybe", "name": float64(1)},
+			wantErr: 4,
+		},
+		{
+			name:    "rejects fractional longs",
+			data:    map[string]any{"age": 1.5},
+			wantErr: 1,
+		},
+		{
+			name:    "rejects unknown fields",
+			data:    map[string]any{"unknown": "x", "address": map[string]any{"zip": "123"}},
+			wantErr: 2,
+		},
+		{
+			name: "accepts null values and nested objects",
+			data: map[string]any{"age": nil, "address": map[string]any{"city": "Paris"}},
+			want: map[string]any{"age": nil, "address": map[string]any{"city": "Paris"}},
+		},
+		{
+			name: "converts array items",
+			data: map[string]any{"age": []any{"1", "2"}},
+			want: map[string]any{"age": []any{int64(1), int64(2)}},
+		},
+		{
+			name: "does not check other types",
+			data: map[string]any{"location": map[string]any{"lat": 1.0, "lon": 2.0}},
+			want: map[string]any{"location": map[string]any{"lat": 1.0, "lon": 2.0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateDataFields(schema, tt.data)
+			if len(errs) != tt.wantErr {
+				t.Fatalf("got %d errors %v, want %d", len(errs), errs, tt.wantErr)
+			}
+			if tt.wantErr == 0 && !reflect.DeepEqual(tt.data, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// InAppMessage represents an in-app message queued for a user
+type InAppMessage struct {
+	MessageID     string         `json:"messageId"`
+	CampaignID    int            `json:"campaignId"`
+	CreatedAt     int64          `json:"createdAt"` // milliseconds
+	ExpiresAt     int64          `json:"expiresAt"` // milliseconds
+	SaveToInbox   bool           `json:"saveToInbox"`
+	Read          bool           `json:"read"`
+	PriorityLevel float64        `json:"priorityLevel"`
+	Content       map[string]any `json:"content,omitempty"`
+	CustomPayload map[string]any `json:"customPayload,omitempty"`
+	Trigger       map[string]any `json:"trigger,omitempty"`
+}
+
+// GetInAppMessagesOpts represents the options for fetching in-app messages
+type GetInAppMessagesOpts struct {
+	Email      string
+	UserID     string
+	Count      int
+	Platform   string // iOS, Android or Web
+	SDKVersion string
+}
+
+// GetInAppMessages retrieves the in-app messages queued for a user
+func (c *Client) GetInAppMessages(opts GetInAppMessagesOpts) (*[]InAppMessage, error) {
+	query := url.Values{}
+	if opts.Email != "" {
+		query.Set("email", opts.Email)
+	}
+	if opts.UserID != "" {
+		query.Set("userId", opts.UserID)
+	}
+	query.Set("count", strconv.Itoa(opts.Count))
+	if opts.Platform != "" {
+		query.Set("platform", opts.Platform)
+	}
+	if opts.SDKVersion != "" {
+		query.Set("SDKVersion", opts.SDKVersion)
+	}
+
+	req, err := c.newRequest("GET", fmt.Sprintf("inApp/getMessages?%s", query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		InAppMessages []InAppMessage `json:"inAppMessages"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.InAppMessages, nil
+}
+
+// ConsumeInAppMessage marks an in-app message as consumed, removing it from
+// the user's queue
+func (c *Client) ConsumeInAppMessage(email, userID, messageID string) error {
+	return c.inAppEvent("events/inAppConsume", email, userID, messageID)
+}
+
+// DeleteInAppMessage deletes an in-app message from the user's inbox
+func (c *Client) DeleteInAppMessage(email, userID, messageID string) error {
+	return c.inAppEvent("events/inAppDelete", email, userID, messageID)
+}
+
+func (c *Client) inAppEvent(path, email, userID, messageID string) error {
+	body := map[string]any{"messageId": messageID}
+	if email != "" {
+		body["email"] = email
+	}
+	if userID != "" {
+		body["userId"] = userID
+	}
+
+	req, err := c.newRequest("POST", path, body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to update in-app message: %v", response)
+	}
+
+	return nil
+}
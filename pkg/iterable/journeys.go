@@ -0,0 +1,78 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Journey represents an Iterable journey (workflow)
+type Journey struct {
+	ID                int      `json:"id"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description,omitempty"`
+	Enabled           bool     `json:"enabled"`
+	IsArchived        bool     `json:"isArchived"`
+	JourneyType       string   `json:"journeyType,omitempty"`
+	TriggerEventNames []string `json:"triggerEventNames,omitempty"`
+	CreatedAt         int64    `json:"createdAt"` // milliseconds
+	UpdatedAt         int64    `json:"updatedAt"` // milliseconds
+}
+
+// TriggerWorkflowRequest represents a request to trigger an API-entry
+// journey for a user or a list
+type TriggerWorkflowRequest struct {
+	WorkflowID int            `json:"workflowId"`
+	Email      string         `json:"email,omitempty"`
+	UserID     string         `json:"userId,omitempty"`
+	ListID     int            `json:"listId,omitempty"`
+	DataFields map[string]any `json:"dataFields,omitempty"`
+}
+
+// TriggerWorkflow triggers a journey for a user or all users in a list
+func (c *Client) TriggerWorkflow(trigger TriggerWorkflowRequest) error {
+	req, err := c.newRequest("POST", "workflows/triggerWorkflow", trigger)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to trigger journey: %v", response)
+	}
+
+	return nil
+}
+
+// GetJourneys retrieves all journeys in the project
+func (c *Client) GetJourneys() (*[]Journey, error) {
+	var journeys []Journey
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("pageSize", "100")
+		req, err := c.newRequest("GET", fmt.Sprintf("journeys?%s", query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Journeys    []Journey `json:"journeys"`
+			NextPageURL string    `json:"nextPageUrl"`
+		}
+		err = c.do(req, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		journeys = append(journeys, response.Journeys...)
+		if response.NextPageURL == "" || len(response.Journeys) == 0 {
+			return &journeys, nil
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SentMessage represents a message sent to a user
+type SentMessage struct {
+	MessageID     string `json:"messageId"`
+	CampaignID    int    `json:"campaignId"`
+	TemplateID    int    `json:"templateId,omitempty"`
+	MessageMedium string `json:"messageMedium"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// GetSentMessagesOpts represents the options for fetching sent messages
+type GetSentMessagesOpts struct {
+	Email       string
+	UserID      string
+	Limit       int
+	Start       time.Time
+	End         time.Time
+	Medium      string // Email, SMS, Push, InApp or WebPush
+	CampaignIDs []int
+}
+
+// GetSentMessages retrieves the messages sent to a user
+func (c *Client) GetSentMessages(opts GetSentMessagesOpts) (*[]SentMessage, error) {
+	query := url.Values{}
+	if opts.Email != "" {
+		query.Set("email", opts.Email)
+	}
+	if opts.UserID != "" {
+		query.Set("userId", opts.UserID)
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if !opts.Start.IsZero() {
+		query.Set("startDateTime", opts.Start.UTC().Format(metricsTimeLayout))
+	}
+	if !opts.End.IsZero() {
+		query.Set("endDateTime", opts.End.UTC().Format(metricsTimeLayout))
+	}
+	if opts.Medium != "" {
+		query.Set("messageMedium", opts.Medium)
+	}
+	for _, id := range opts.CampaignIDs {
+		query.Add("campaignIds", strconv.Itoa(id))
+	}
+
+	req, err := c.newRequest("GET", fmt.Sprintf("users/getSentMessages?%s", query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Messages []SentMessage `json:"messages"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Messages, nil
+}
+
+// ViewEmail retrieves the HTML of an email sent to a user
+func (c *Client) ViewEmail(email, messageID string) ([]byte, error) {
+	query := url.Values{}
+	query.Set("email", email)
+	query.Set("messageId", messageID)
+	req, err := c.newRequest("GET", fmt.Sprintf("email/viewInBrowser?%s", query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRaw(req)
+}
@@ -0,0 +1,39 @@
+package iterable
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+)
+
+// metricsTimeLayout is the date format expected by the metrics endpoints
+const metricsTimeLayout = "2006-01-02 15:04:05"
+
+// Metrics represents a CSV metrics report returned by Iterable
+type Metrics struct {
+	Columns []string
+	Rows    [][]string
+}
+
+// Value returns the value of the named column in the given row
+func (m *Metrics) Value(row int, column string) string {
+	for i, c := range m.Columns {
+		if c == column && i < len(m.Rows[row]) {
+			return m.Rows[row][i]
+		}
+	}
+	return ""
+}
+
+// parseMetrics parses a CSV report with a header row
+func parseMetrics(data []byte) (*Metrics, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return &Metrics{}, nil
+	}
+
+	return &Metrics{Columns: records[0], Rows: records[1:]}, nil
+}
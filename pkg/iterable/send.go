@@ -0,0 +1,70 @@
+package iterable
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// targetPaths maps send mediums to their target endpoints
+var targetPaths = map[string]string{
+	"email": "email/target",
+	"sms":   "sms/target",
+	"push":  "push/target",
+	"inapp": "inApp/target",
+}
+
+// TargetRequest represents a request to send a campaign to a single user
+type TargetRequest struct {
+	CampaignID                int
+	RecipientEmail            string
+	RecipientUserID           string
+	DataFields                map[string]any
+	SendAt                    time.Time // zero sends immediately
+	AllowRepeatMarketingSends bool
+}
+
+// SendTarget sends a campaign of the given medium (email, sms, push or
+// inapp) to a single user
+func (c *Client) SendTarget(medium string, target TargetRequest) error {
+	path, ok := targetPaths[medium]
+	if !ok {
+		return fmt.Errorf("invalid send medium %q, must be one of: %s", medium, strings.Join(TemplateMediums, ", "))
+	}
+
+	body := map[string]any{
+		"campaignId": target.CampaignID,
+	}
+	if target.RecipientEmail != "" {
+		body["recipientEmail"] = target.RecipientEmail
+	}
+	if target.RecipientUserID != "" {
+		body["recipientUserId"] = target.RecipientUserID
+	}
+	if len(target.DataFields) > 0 {
+		body["dataFields"] = target.DataFields
+	}
+	if !target.SendAt.IsZero() {
+		body["sendAt"] = target.SendAt.UTC().Format(campaignTimeLayout)
+	}
+	if target.AllowRepeatMarketingSends {
+		body["allowRepeatMarketingSends"] = true
+	}
+
+	req, err := c.newRequest("POST", path, body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to send %s: %v", medium, response)
+	}
+
+	return nil
+}
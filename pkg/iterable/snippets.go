@@ -0,0 +1,123 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Snippet represents a reusable piece of template content
+type Snippet struct {
+	ID          int      `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Content     string   `json:"content"`
+	Description string   `json:"description,omitempty"`
+	Variables   []string `json:"variables,omitempty"`
+	CreatedAt   int64    `json:"createdAt,omitempty"` // milliseconds
+	UpdatedAt   int64    `json:"updatedAt,omitempty"` // milliseconds
+	CreatedBy   string   `json:"createdBy,omitempty"`
+	UpdatedBy   string   `json:"updatedBy,omitempty"`
+}
+
+// snippetPath builds the path of a snippet by ID or name
+func snippetPath(identifier string) string {
+	return fmt.Sprintf("snippets/%s", url.PathEscape(identifier))
+}
+
+// snippetVariables returns the variables of a snippet, sending an empty
+// list rather than null when there are none
+func snippetVariables(snippet Snippet) []string {
+	if snippet.Variables == nil {
+		return []string{}
+	}
+	return snippet.Variables
+}
+
+// GetSnippets retrieves all snippets in the project
+func (c *Client) GetSnippets() (*[]Snippet, error) {
+	req, err := c.newRequest("GET", "snippets", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Snippets []Snippet `json:"snippets"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Snippets, nil
+}
+
+// GetSnippet retrieves a snippet by ID or name
+func (c *Client) GetSnippet(identifier string) (*Snippet, error) {
+	req, err := c.newRequest("GET", snippetPath(identifier), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Snippet Snippet `json:"snippet"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Snippet, nil
+}
+
+// CreateSnippet creates a snippet and returns its ID
+func (c *Client) CreateSnippet(snippet Snippet) (int, error) {
+	body := map[string]any{
+		"snippet": map[string]any{
+			"name":        snippet.Name,
+			"content":     snippet.Content,
+			"description": snippet.Description,
+			"variables":   snippetVariables(snippet),
+		},
+	}
+	req, err := c.newRequest("POST", "snippets", body)
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		SnippetID int `json:"snippetId"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.SnippetID, nil
+}
+
+// UpdateSnippet updates the content, description and variables of a snippet
+// by ID or name
+func (c *Client) UpdateSnippet(identifier string, snippet Snippet) error {
+	body := map[string]any{
+		"snippet": map[string]any{
+			"content":     snippet.Content,
+			"description": snippet.Description,
+			"variables":   snippetVariables(snippet),
+		},
+	}
+	req, err := c.newRequest("PUT", snippetPath(identifier), body)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// DeleteSnippet deletes a snippet by ID or name
+func (c *Client) DeleteSnippet(identifier string) error {
+	req, err := c.newRequest("DELETE", snippetPath(identifier), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
@@ -0,0 +1,182 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Channel represents an Iterable message channel
+type Channel struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	ChannelType   string `json:"channelType"`
+	MessageMedium string `json:"messageMedium"`
+}
+
+// MessageType represents an Iterable message type
+type MessageType struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	ChannelID          int    `json:"channelId"`
+	SubscriptionPolicy string `json:"subscriptionPolicy,omitempty"`
+}
+
+// SubscriptionUpdate represents a request to replace a user's subscriptions.
+// Nil lists are left unchanged, empty lists clear them.
+type SubscriptionUpdate struct {
+	Email                      string
+	UserID                     string
+	EmailListIDs               []int
+	UnsubscribedChannelIDs     []int
+	UnsubscribedMessageTypeIDs []int
+	SubscribedMessageTypeIDs   []int
+}
+
+// GetChannels retrieves the message channels in the project
+func (c *Client) GetChannels() (*[]Channel, error) {
+	req, err := c.newRequest("GET", "channels", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Channels []Channel `json:"channels"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Channels, nil
+}
+
+// GetMessageTypes retrieves the message types in the project
+func (c *Client) GetMessageTypes() (*[]MessageType, error) {
+	req, err := c.newRequest("GET", "messageTypes", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		MessageTypes []MessageType `json:"messageTypes"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.MessageTypes, nil
+}
+
+// UpdateSubscriptions replaces the subscription lists of a user
+func (c *Client) UpdateSubscriptions(update SubscriptionUpdate) error {
+	body := map[string]any{}
+	if update.Email != "" {
+		body["email"] = update.Email
+	}
+	if update.UserID != "" {
+		body["userId"] = update.UserID
+	}
+	if update.EmailListIDs != nil {
+		body["emailListIds"] = update.EmailListIDs
+	}
+	if update.UnsubscribedChannelIDs != nil {
+		body["unsubscribedChannelIds"] = update.UnsubscribedChannelIDs
+	}
+	if update.UnsubscribedMessageTypeIDs != nil {
+		body["unsubscribedMessageTypeIds"] = update.UnsubscribedMessageTypeIDs
+	}
+	if update.SubscribedMessageTypeIDs != nil {
+		body["subscribedMessageTypeIds"] = update.SubscribedMessageTypeIDs
+	}
+
+	req, err := c.newRequest("POST", "users/updateSubscriptions", body)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to update subscriptions: %v", response)
+	}
+
+	return nil
+}
+
+// Subscribe subscribes a user, by email or by user ID, to a subscription
+// group (emailList, messageType or messageChannel)
+func (c *Client) Subscribe(group string, groupID int, email, userID string) error {
+	return c.subscription("PATCH", group, groupID, email, userID)
+}
+
+// Unsubscribe unsubscribes a user, by email or by user ID, from a
+// subscription group (emailList, messageType or messageChannel)
+func (c *Client) Unsubscribe(group string, groupID int, email, userID string) error {
+	return c.subscription("DELETE", group, groupID, email, userID)
+}
+
+func (c *Client) subscription(method, group string, groupID int, email, userID string) error {
+	path := fmt.Sprintf("subscriptions/%s/%d/user/%s", group, groupID, url.PathEscape(email))
+	if userID != "" {
+		path = fmt.Sprintf("subscriptions/%s/%d/byUserId/%s", group, groupID, url.PathEscape(userID))
+	}
+
+	req, err := c.newRequest(method, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to update subscription: %v", response)
+	}
+
+	return nil
+}
+
+// BulkSubscriptionResponse represents the result of a bulk subscription
+// update
+type BulkSubscriptionResponse struct {
+	SuccessCount   int      `json:"successCount"`
+	FailCount      int      `json:"failCount"`
+	InvalidEmails  []string `json:"invalidEmails,omitempty"`
+	InvalidUserIds []string `json:"invalidUserIds,omitempty"`
+}
+
+// BulkUpdateSubscription subscribes or unsubscribes many users at once.
+// Action is either subscribe or unsubscribe.
+func (c *Client) BulkUpdateSubscription(group string, groupID int, action string, emails, userIDs []string) (*BulkSubscriptionResponse, error) {
+	body := map[string]any{}
+	if len(emails) > 0 {
+		body["users"] = emails
+	}
+	if len(userIDs) > 0 {
+		body["usersByUserId"] = userIDs
+	}
+
+	query := url.Values{}
+	query.Set("action", action)
+	path := fmt.Sprintf("subscriptions/%s/%d?%s", group, groupID, query.Encode())
+	req, err := c.newRequest("PUT", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response BulkSubscriptionResponse
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,179 @@
+package iterable
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// templateMediums maps template mediums used in endpoint paths to the
+// messageMedium values used when listing templates
+var templateMediums = map[string]string{
+	"email": "Email",
+	"sms":   "SMS",
+	"push":  "Push",
+	"inapp": "InApp",
+}
+
+// TemplateMediums lists the supported template mediums
+var TemplateMediums = []string{"email", "sms", "push", "inapp"}
+
+// TemplateSummary represents a template returned when listing templates
+type TemplateSummary struct {
+	TemplateID       int    `json:"templateId"`
+	Name             string `json:"name"`
+	CreatedAt        int    `json:"createdAt"`
+	UpdatedAt        int    `json:"updatedAt"`
+	CreatorUserID    string `json:"creatorUserId,omitempty"`
+	MessageTypeID    int    `json:"messageTypeId,omitempty"`
+	CampaignID       int    `json:"campaignId,omitempty"`
+	ClientTemplateID string `json:"clientTemplateId,omitempty"`
+}
+
+// Template represents a full template. Fields differ by medium, so templates
+// are kept as raw objects to round-trip every field.
+type Template map[string]any
+
+// TemplateContentField returns the field holding a template's main content
+// for the given medium
+func TemplateContentField(medium string) string {
+	switch medium {
+	case "email", "inapp":
+		return "html"
+	default:
+		return "message"
+	}
+}
+
+// checkTemplateMedium returns an error for unsupported template mediums
+func checkTemplateMedium(medium string) error {
+	if _, ok := templateMediums[medium]; !ok {
+		return fmt.Errorf("invalid template medium %q, must be one of: %s", medium, strings.Join(TemplateMediums, ", "))
+	}
+	return nil
+}
+
+// GetTemplates lists templates, optionally filtered by template type
+// (Base, Blast, Triggered or Workflow) and medium
+func (c *Client) GetTemplates(templateType, medium string) (*[]TemplateSummary, error) {
+	query := url.Values{}
+	if templateType != "" {
+		query.Set("templateType", templateType)
+	}
+	if medium != "" {
+		if err := checkTemplateMedium(medium); err != nil {
+			return nil, err
+		}
+		query.Set("messageMedium", templateMediums[medium])
+	}
+
+	path := "templates"
+	if len(query) > 0 {
+		path = fmt.Sprintf("templates?%s", query.Encode())
+	}
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Templates []TemplateSummary `json:"templates"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Templates, nil
+}
+
+// GetTemplate retrieves a template of the given medium by ID
+func (c *Client) GetTemplate(medium string, templateID int) (Template, error) {
+	if err := checkTemplateMedium(medium); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("templateId", strconv.Itoa(templateID))
+	path := fmt.Sprintf("templates/%s/get?%s", medium, query.Encode())
+	req, err := c.newRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template Template
+	err = c.do(req, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// UpsertTemplate creates or updates a template of the given medium. Templates
+// with a clientTemplateId are upserted by it, otherwise the template with the
+// given templateId is updated.
+func (c *Client) UpsertTemplate(medium string, template Template) error {
+	if err := checkTemplateMedium(medium); err != nil {
+		return err
+	}
+
+	action := "update"
+	if id, _ := template["clientTemplateId"].(string); id != "" {
+		action = "upsert"
+	} else if template["templateId"] == nil {
+		return fmt.Errorf("template requires a templateId or clientTemplateId")
+	}
+
+	path := fmt.Sprintf("templates/%s/%s", medium, action)
+	req, err := c.newRequest("POST", path, template)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to %s template: %v", action, response)
+	}
+
+	return nil
+}
+
+// ProofRequest represents a request to send a template proof
+type ProofRequest struct {
+	TemplateID      int            `json:"templateId"`
+	RecipientEmail  string         `json:"recipientEmail,omitempty"`
+	RecipientUserID string         `json:"recipientUserId,omitempty"`
+	DataFields      map[string]any `json:"dataFields,omitempty"`
+}
+
+// SendTemplateProof sends a proof of a template of the given medium
+func (c *Client) SendTemplateProof(medium string, proof ProofRequest) error {
+	if err := checkTemplateMedium(medium); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("templates/%s/proof", medium)
+	req, err := c.newRequest("POST", path, proof)
+	if err != nil {
+		return err
+	}
+
+	var response APIError
+	err = c.do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Code != "Success" {
+		return fmt.Errorf("failed to send proof: %v", response)
+	}
+
+	return nil
+}
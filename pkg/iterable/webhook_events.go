@@ -0,0 +1,190 @@
+package iterable
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WebhookEvent represents an event posted by an Iterable system webhook
+type WebhookEvent struct {
+	Email      string          `json:"email,omitempty"`
+	UserID     string          `json:"userId,omitempty"`
+	EventName  string          `json:"eventName"`
+	DataFields json.RawMessage `json:"dataFields,omitempty"`
+}
+
+// MessageEventFields are the fields shared by message events
+type MessageEventFields struct {
+	CreatedAt     string `json:"createdAt,omitempty"`
+	CampaignID    int    `json:"campaignId,omitempty"`
+	CampaignName  string `json:"campaignName,omitempty"`
+	TemplateID    int    `json:"templateId,omitempty"`
+	TemplateName  string `json:"templateName,omitempty"`
+	WorkflowID    int    `json:"workflowId,omitempty"`
+	WorkflowName  string `json:"workflowName,omitempty"`
+	MessageID     string `json:"messageId,omitempty"`
+	MessageTypeID int    `json:"messageTypeId,omitempty"`
+	ChannelID     int    `json:"channelId,omitempty"`
+	ExperimentID  int    `json:"experimentId,omitempty"`
+	Locale        string `json:"locale,omitempty"`
+}
+
+// EmailSendEvent is the data of an emailSend event
+type EmailSendEvent struct {
+	MessageEventFields
+	EmailSubject      string         `json:"emailSubject,omitempty"`
+	TransactionalData map[string]any `json:"transactionalData,omitempty"`
+}
+
+// EmailOpenEvent is the data of an emailOpen event
+type EmailOpenEvent struct {
+	MessageEventFields
+	EmailSubject string `json:"emailSubject,omitempty"`
+	UserAgent    string `json:"userAgent,omitempty"`
+	IP           string `json:"ip,omitempty"`
+	ProxySource  string `json:"proxySource,omitempty"`
+}
+
+// EmailClickEvent is the data of an emailClick event
+type EmailClickEvent struct {
+	MessageEventFields
+	EmailSubject string `json:"emailSubject,omitempty"`
+	URL          string `json:"url,omitempty"`
+	HrefIndex    int    `json:"hrefIndex,omitempty"`
+	UserAgent    string `json:"userAgent,omitempty"`
+	IP           string `json:"ip,omitempty"`
+}
+
+// EmailBounceEvent is the data of an emailBounce event
+type EmailBounceEvent struct {
+	MessageEventFields
+	EmailSubject   string `json:"emailSubject,omitempty"`
+	RecipientState string `json:"recipientState,omitempty"`
+}
+
+// EmailUnsubscribeEvent is the data of emailUnSubscribe, emailSubscribe and
+// emailComplaint events
+type EmailUnsubscribeEvent struct {
+	MessageEventFields
+	EmailSubject string `json:"emailSubject,omitempty"`
+	UnsubSource  string `json:"unsubSource,omitempty"`
+	EmailListIDs []int  `json:"emailListIds,omitempty"`
+}
+
+// SMSSendEvent is the data of smsSend and smsBounce events
+type SMSSendEvent struct {
+	MessageEventFields
+	ToPhoneNumber   string `json:"toPhoneNumber,omitempty"`
+	FromSMSSenderID int    `json:"fromSMSSenderId,omitempty"`
+	ContentBody     string `json:"contentBody,omitempty"`
+}
+
+// SMSReceivedEvent is the data of an smsReceived event
+type SMSReceivedEvent struct {
+	CreatedAt       string `json:"createdAt,omitempty"`
+	FromPhoneNumber string `json:"fromPhoneNumber,omitempty"`
+	ToPhoneNumber   string `json:"toPhoneNumber,omitempty"`
+	SMSMessage      string `json:"smsMessage,omitempty"`
+}
+
+// PushEvent is the data of pushSend, pushOpen, pushBounce and pushUninstall
+// events
+type PushEvent struct {
+	MessageEventFields
+	PushMessage       string         `json:"pushMessage,omitempty"`
+	Platform          string         `json:"platform,omitempty"`
+	AppAlreadyRunning bool           `json:"appAlreadyRunning,omitempty"`
+	Payload           map[string]any `json:"payload,omitempty"`
+}
+
+// InAppEvent is the data of inAppSend, inAppOpen, inAppClick and inAppClose
+// events
+type InAppEvent struct {
+	MessageEventFields
+	ClickedURL string `json:"clickedUrl,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+}
+
+// webhookEventTypes maps known event names to a constructor of their data type
+var webhookEventTypes = map[string]func() any{
+	"emailSend":        func() any { return &EmailSendEvent{} },
+	"emailOpen":        func() any { return &EmailOpenEvent{} },
+	"emailClick":       func() any { return &EmailClickEvent{} },
+	"emailBounce":      func() any { return &EmailBounceEvent{} },
+	"emailSendSkip":    func() any { return &EmailSendEvent{} },
+	"emailComplaint":   func() any { return &EmailUnsubscribeEvent{} },
+	"emailSubscribe":   func() any { return &EmailUnsubscribeEvent{} },
+	"emailUnSubscribe": func() any { return &EmailUnsubscribeEvent{} },
+	"smsSend":          func() any { return &SMSSendEvent{} },
+	"smsBounce":        func() any { return &SMSSendEvent{} },
+	"smsReceived":      func() any { return &SMSReceivedEvent{} },
+	"pushSend":         func() any { return &PushEvent{} },
+	"pushOpen":         func() any { return &PushEvent{} },
+	"pushBounce":       func() any { return &PushEvent{} },
+	"pushUninstall":    func() any { return &PushEvent{} },
+	"inAppSend":        func() any { return &InAppEvent{} },
+	"inAppOpen":        func() any { return &InAppEvent{} },
+	"inAppClick":       func() any { return &InAppEvent{} },
+	"inAppClose":       func() any { return &InAppEvent{} },
+}
+
+// ParseWebhookEvent parses a webhook request body. The returned data is a
+// pointer to the typed data of a known event, or the raw data fields as a
+// map for other events. If the data fields of a known event do not match its
+// type, the event is returned with its raw data fields and an error.
+func ParseWebhookEvent(body []byte) (*WebhookEvent, any, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, nil, fmt.Errorf("invalid webhook payload: %v", err)
+	}
+	if event.EventName == "" {
+		return nil, nil, fmt.Errorf("invalid webhook payload: missing eventName")
+	}
+
+	var data any = &map[string]any{}
+	if newData, ok := webhookEventTypes[event.EventName]; ok {
+		data = newData()
+	}
+	if len(event.DataFields) > 0 {
+		if err := json.Unmarshal(event.DataFields, data); err != nil {
+			raw := map[string]any{}
+			if rawErr := json.Unmarshal(event.DataFields, &raw); rawErr != nil {
+				return nil, nil, fmt.Errorf("invalid %s data fields: %v", event.EventName, rawErr)
+			}
+			return &event, &raw, fmt.Errorf("unexpected %s data fields: %v", event.EventName, err)
+		}
+	}
+
+	return &event, data, nil
+}
+
+// ExtraDataFields returns the data fields of an event that its typed data
+// does not show, either because the type has no such field or because the
+// value is empty and omitted, such as a zero hrefIndex.
+func ExtraDataFields(event *WebhookEvent, data any) (map[string]any, error) {
+	extra := map[string]any{}
+	if len(event.DataFields) == 0 {
+		return extra, nil
+	}
+
+	raw := map[string]any{}
+	if err := json.Unmarshal(event.DataFields, &raw); err != nil {
+		return nil, err
+	}
+
+	typedJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	typed := map[string]any{}
+	if err := json.Unmarshal(typedJSON, &typed); err != nil {
+		return nil, err
+	}
+
+	for k, v := range raw {
+		if _, ok := typed[k]; !ok {
+			extra[k] = v
+		}
+	}
+	return extra, nil
+}
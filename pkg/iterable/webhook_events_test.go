@@ -0,0 +1,88 @@
+package iterable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantEvent bool
+		wantData  any
+		wantExtra map[string]any
+		wantErr   bool
+	}{
+		{
+			name:      "known event",
+			body:      `{"email":"a@example.com","eventName":"emailOpen","dataFields":{"campaignId":12,"messageId":"m1","ip":"1.2.3.4"}}`,
+			wantEvent: true,
+			wantData:  &EmailOpenEvent{MessageEventFields: MessageEventFields{CampaignID: 12, MessageID: "m1"}, IP: "1.2.3.4"},
+			wantExtra: map[string]any{},
+		},
+		{
+			name:      "known event with untyped and zero fields",
+			body:      `{"email":"a@example.com","eventName":"emailClick","dataFields":{"url":"https://example.com","hrefIndex":0,"linkId":"l1"}}`,
+			wantEvent: true,
+			wantData:  &EmailClickEvent{URL: "https://example.com"},
+			wantExtra: map[string]any{"hrefIndex": float64(0), "linkId": "l1"},
+		},
+		{
+			name:      "unknown event",
+			body:      `{"userId":"42","eventName":"customEvent","dataFields":{"plan":"pro"}}`,
+			wantEvent: true,
+			wantData:  &map[string]any{"plan": "pro"},
+			wantExtra: map[string]any{},
+		},
+		{
+			name:      "known event with mismatched field types",
+			body:      `{"eventName":"emailClick","dataFields":{"hrefIndex":"first"}}`,
+			wantEvent: true,
+			wantData:  &map[string]any{"hrefIndex": "first"},
+			wantExtra: map[string]any{},
+			wantErr:   true,
+		},
+		{
+			name:    "data fields that are not an object",
+			body:    `{"eventName":"emailClick","dataFields":[1,2]}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing event name",
+			body:    `{"email":"a@example.com"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			body:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, data, err := ParseWebhookEvent([]byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWebhookEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (event != nil) != tt.wantEvent {
+				t.Fatalf("got event %v, want event %v", event, tt.wantEvent)
+			}
+			if event == nil {
+				return
+			}
+			if !reflect.DeepEqual(data, tt.wantData) {
+				t.Errorf("got data %#v, want %#v", data, tt.wantData)
+			}
+
+			extra, err := ExtraDataFields(event, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(extra, tt.wantExtra) {
+				t.Errorf("got extra fields %#v, want %#v", extra, tt.wantExtra)
+			}
+		})
+	}
+}
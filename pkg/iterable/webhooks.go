@@ -0,0 +1,71 @@
+package iterable
+
+// Webhook represents a system webhook configured in the project
+type Webhook struct {
+	ID                   int    `json:"id"`
+	Endpoint             string `json:"endpoint"`
+	Enabled              bool   `json:"enabled"`
+	AuthType             string `json:"authType,omitempty"`
+	BlastSendEnabled     bool   `json:"blastSendEnabled"`
+	TriggeredSendEnabled bool   `json:"triggeredSendEnabled"`
+	ChannelIDs           []int  `json:"channelIds"`
+	MessageTypeIDs       []int  `json:"messageTypeIds"`
+	CreatedAt            int64  `json:"createdAt,omitempty"` // milliseconds
+	UpdatedAt            int64  `json:"updatedAt,omitempty"` // milliseconds
+}
+
+// WebhookUpdate represents a change to a webhook. Nil fields are left
+// unchanged.
+type WebhookUpdate struct {
+	ID                   int
+	Enabled              *bool
+	BlastSendEnabled     *bool
+	TriggeredSendEnabled *bool
+	ChannelIDs           []int
+	MessageTypeIDs       []int
+}
+
+// GetWebhooks retrieves the system webhooks of the project
+func (c *Client) GetWebhooks() (*[]Webhook, error) {
+	req, err := c.newRequest("GET", "webhooks", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Webhooks []Webhook `json:"webhooks"`
+	}
+	err = c.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Webhooks, nil
+}
+
+// UpdateWebhook updates the settings of a webhook
+func (c *Client) UpdateWebhook(update WebhookUpdate) error {
+	body := map[string]any{"id": update.ID}
+	if update.Enabled != nil {
+		body["enabled"] = *update.Enabled
+	}
+	if update.BlastSendEnabled != nil {
+		body["blastSendEnabled"] = *update.BlastSendEnabled
+	}
+	if update.TriggeredSendEnabled != nil {
+		body["triggeredSendEnabled"] = *update.TriggeredSendEnabled
+	}
+	if update.ChannelIDs != nil {
+		body["channelIds"] = update.ChannelIDs
+	}
+	if update.MessageTypeIDs != nil {
+		body["messageTypeIds"] = update.MessageTypeIDs
+	}
+
+	req, err := c.newRequest("POST", "webhooks", body)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
@@ -0,0 +1,5 @@
+package utils
+
+// NoAPIKeyAnnotation is a command annotation marking commands that do not
+// call the Iterable API and therefore run without an API key
+const NoAPIKeyAnnotation = "iterablectl/no-api-key"
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"strings"
+)
+
+// ParseDataFields builds a data fields object from key=value pairs and an
+// optional JSON file. Values from the file take precedence.
+func ParseDataFields(pairs []string, file string) (map[string]any, error) {
+	dataFields := make(map[string]any)
+	for _, field := range pairs {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid data field format: %s, expected format is key=value", field)
+		}
+
+		key, value := parts[0], parts[1]
+		dataFields[key] = value
+	}
+
+	if file != "" {
+		fileData, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data file: %v", err)
+		}
+
+		var fileFields map[string]any
+		if err := json.Unmarshal(fileData, &fileFields); err != nil {
+			return nil, fmt.Errorf("failed to parse data file as JSON: %v", err)
+		}
+
+		maps.Copy(dataFields, fileFields)
+	}
+
+	return dataFields, nil
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseDataFields(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "data.json")
+	if err := os.WriteFile(file, []byte(`{"plan":"pro","age":42,"address":{"city":"Paris"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalid, []byte(`[1, 2]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		pairs   []string
+		file    string
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name: "no fields",
+			want: map[string]any{},
+		},
+		{
+			name:  "pairs are strings",
+			pairs: []string{"plan=basic", "age=42"},
+			want:  map[string]any{"plan": "basic", "age": "42"},
+		},
+		{
+			name:  "values may contain equals signs",
+			pairs: []string{"query=a=b"},
+			want:  map[string]any{"query": "a=b"},
+		},
+		{
+			name:  "empty values",
+			pairs: []string{"plan="},
+			want:  map[string]any{"plan": ""},
+		},
+		{
+			name:  "file values take precedence",
+			pairs: []string{"plan=basic", "source=cli"},
+			file:  file,
+			want:  map[string]any{"plan": "pro", "age": float64(42), "address": map[string]any{"city": "Paris"}, "source": "cli"},
+		},
+		{
+			name:    "pair without equals sign",
+			pairs:   []string{"plan"},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			file:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "file is not an object",
+			file:    invalid,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDataFields(tt.pairs, tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDataFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
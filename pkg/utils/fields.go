@@ -0,0 +1,34 @@
+package utils
+
+import "strings"
+
+// SetPath sets value in data at the dot-separated path, creating intermediate
+// objects as needed. Existing non-object values along the path are replaced.
+func SetPath(data map[string]any, path string, value any) {
+	parts := strings.Split(path, ".")
+	current := data
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[part] = next
+		}
+		current = next
+	}
+	current[parts[len(parts)-1]] = value
+}
+
+// GetPath returns the value in data at the dot-separated path
+func GetPath(data map[string]any, path string) (any, bool) {
+	parts := strings.Split(path, ".")
+	current := data
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	value, ok := current[parts[len(parts)-1]]
+	return value, ok
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  map[string]any
+		path  string
+		value any
+		want  map[string]any
+	}{
+		{
+			name:  "top-level field",
+			data:  map[string]any{},
+			path:  "plan",
+			value: "pro",
+			want:  map[string]any{"plan": "pro"},
+		},
+		{
+			name:  "creates intermediate objects",
+			data:  map[string]any{},
+			path:  "address.geo.lat",
+			value: 1.5,
+			want:  map[string]any{"address": map[string]any{"geo": map[string]any{"lat": 1.5}}},
+		},
+		{
+			name:  "keeps sibling fields",
+			data:  map[string]any{"address": map[string]any{"city": "Paris"}},
+			path:  "address.zip",
+			value: nil,
+			want:  map[string]any{"address": map[string]any{"city": "Paris", "zip": nil}},
+		},
+		{
+			name:  "replaces non-object values along the path",
+			data:  map[string]any{"address": "unknown"},
+			path:  "address.city",
+			value: "Paris",
+			want:  map[string]any{"address": map[string]any{"city": "Paris"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetPath(tt.data, tt.path, tt.value)
+			if !reflect.DeepEqual(tt.data, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	data := map[string]any{
+		"plan":    "pro",
+		"address": map[string]any{"city": "Paris", "zip": nil},
+	}
+
+	tests := []struct {
+		path   string
+		want   any
+		wantOK bool
+	}{
+		{"plan", "pro", true},
+		{"address.city", "Paris", true},
+		{"address.zip", nil, true},
+		{"address.street", nil, false},
+		{"plan.name", nil, false},
+		{"missing", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, ok := GetPath(data, tt.path)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPath(%q) = %v, %v, want %v, %v", tt.path, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// ReadLines reads a file and returns its non-empty lines with surrounding
+// whitespace trimmed
+func ReadLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseIDs parses a list of numeric IDs given as strings. Unlike an IntSlice
+// flag, an empty flag value parses as an empty list, which lets commands
+// clear a list of IDs.
+func ParseIDs(values []string) ([]int, error) {
+	ids := make([]int, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q", value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []int
+		wantErr bool
+	}{
+		{"IDs", []string{"1", " 2 ", "3"}, []int{1, 2, 3}, false},
+		{"empty value clears the list", []string{}, []int{}, false},
+		{"blank values are skipped", []string{""}, []int{}, false},
+		{"invalid ID", []string{"1", "x"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIDs(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+)
+
+// mergeField matches {{path}} and {{{path}}}
+var mergeField = regexp.MustCompile(`\{\{(\{?)\s*([\w.-]+)\s*\}?\}\}`)
+
+// RenderMergeFields replaces Handlebars-style merge fields such as
+// {{firstName}} or {{{address.street}}} with values from data. Values in
+// double braces are HTML-escaped, values in triple braces are not. Block
+// helpers are not evaluated, and fields missing from data are left as-is
+// and returned as unresolved.
+func RenderMergeFields(tmpl string, data map[string]any) (string, []string) {
+	var unresolved []string
+	out := mergeField.ReplaceAllStringFunc(tmpl, func(match string) string {
+		groups := mergeField.FindStringSubmatch(match)
+		raw, path := groups[1] != "", groups[2]
+
+		value, ok := GetPath(data, path)
+		if !ok {
+			unresolved = append(unresolved, path)
+			return match
+		}
+
+		s := mergeValue(value)
+		if !raw {
+			s = html.EscapeString(s)
+		}
+		return s
+	})
+	return out, unresolved
+}
+
+// mergeValue formats a value the way it appears when merged into a template
+func mergeValue(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		return string(b)
+	}
+}
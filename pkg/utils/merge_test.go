@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderMergeFields(t *testing.T) {
+	data := map[string]any{
+		"firstName": "Ada",
+		"bio":       "<b>Hi</b>",
+		"points":    float64(1234567),
+		"vip":       true,
+		"nickname":  nil,
+		"address":   map[string]any{"city": "Paris"},
+		"tags":      []any{"a", "b"},
+	}
+
+	tests := []struct {
+		name           string
+		tmpl           string
+		want           string
+		wantUnresolved []string
+	}{
+		{"plain field", "Hi {{firstName}}!", "Hi Ada!", nil},
+		{"whitespace inside braces", "Hi {{ firstName }}!", "Hi Ada!", nil},
+		{"nested field", "{{address.city}}", "Paris", nil},
+		{"escaped by default", "{{bio}}", "&lt;b&gt;Hi&lt;/b&gt;", nil},
+		{"triple braces are raw", "{{{bio}}}", "<b>Hi</b>", nil},
+		{"numbers without exponent", "{{points}}", "1234567", nil},
+		{"booleans", "{{vip}}", "true", nil},
+		{"null values are empty", "[{{nickname}}]", "[]", nil},
+		{"arrays as JSON", "{{{tags}}}", `["a","b"]`, nil},
+		{"missing fields are kept", "Hi {{lastName}} {{{address.zip}}}", "Hi {{lastName}} {{{address.zip}}}", []string{"lastName", "address.zip"}},
+		{"block helpers are not evaluated", "{{#if vip}}VIP{{/if}}", "{{#if vip}}VIP{{/if}}", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unresolved := RenderMergeFields(tt.tmpl, data)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(unresolved, tt.wantUnresolved) {
+				t.Errorf("got unresolved %q, want %q", unresolved, tt.wantUnresolved)
+			}
+		})
+	}
+}
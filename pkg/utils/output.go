@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PrintJSON prints v as indented JSON to stdout
+func PrintJSON(v any) error {
+	jsonOutput, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error formatting JSON: %v", err)
+	}
+	fmt.Println(string(jsonOutput))
+	return nil
+}
@@ -1,5 +1,7 @@
 package utils
 
+import "strings"
+
 // Truncate truncates a string if it's longer than maxLen
 func Truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -7,3 +9,11 @@ func Truncate(s string, maxLen int) string {
 	}
 	return s[:maxLen-3] + "..."
 }
+
+// Capitalize returns s with its first letter in upper case
+func Capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}